@@ -1,22 +1,28 @@
 package cli
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	log "log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
-	"github.com/kyma-project/infrastructure-manager/pkg/config"
-
-	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clientNameKcp      = "kcp"
+	clientNameGardener = "gardener"
 )
 
 var (
@@ -27,19 +33,6 @@ var (
 	}
 )
 
-func loadConverterConfig(path string) (cfg config.Config, err error) {
-	tolerationFile, err := os.Open(path)
-	if err != nil {
-		return cfg, fmt.Errorf("unable to open tolerations config file %s: %w", path, err)
-	}
-
-	err = json.NewDecoder(tolerationFile).Decode(&cfg)
-	if err != nil {
-		return cfg, fmt.Errorf("unable to decode tolerations config file %s: %w", path, err)
-	}
-	return cfg, nil
-}
-
 func Run() error {
 	defer seeker.LogWithDuration(time.Now(), "application finished")
 	defer haltIstioSidecar()
@@ -52,23 +45,97 @@ func Run() error {
 	logLevel := mustParseLogLevel(cfg.LogLevel)
 	slog.SetLogLoggerLevel(logLevel)
 
-	var tolerations config.TolerationsConfig
-	if converterCfg, err := loadConverterConfig(cfg.ConverterConfigFilepath); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	converterCfg, err := loadConverterConfig(cfg.ConverterConfigFilepath, cfg.optionalStages())
+	if err != nil {
 		return err
-	} else {
-		tolerations = converterCfg.ConverterConfig.Tolerations
 	}
+	opts := seedOpts(cfg, converterCfg)
 
-	kcpClient, err := client.New(client.Options{
+	kcpOpts := withClientLimits(client.Options{
 		AdditionalAddToSchema: []func(*runtime.Scheme) error{
 			corev1.AddToScheme,
 		},
-	}, "kcp")
+	}, cfg.Kcp.Client, clientNameKcp)
+
+	if cfg.Command == CommandValidateConfig {
+		return validateConverterConfig(ctx, cfg, lazyKcpGet(kcpOpts), opts)
+	}
+
+	if cfg.Command == CommandSimulate {
+		gardenerClient, err := newGardenerClient(cfg, lazyKcpGet(kcpOpts))
+		if err != nil {
+			return err
+		}
+		return simulate(ctx, cfg, newSource(cfg, gardenerClient), opts, os.Stdout)
+	}
+
+	kcpClient, err := client.New(kcpOpts, clientNameKcp)
+
+	if err != nil {
+		return err
+	}
 
+	gardenerClient, err := newGardenerClient(cfg, kcpClient.Get)
 	if err != nil {
 		return err
 	}
 
+	if cfg.Command == CommandWatch {
+		watch := func(ctx context.Context) error {
+			return runWatch(ctx, cfg, converterCfg, kcpClient, gardenerClient)
+		}
+
+		if cfg.LeaderElection.Enabled {
+			return runWithLeaderElection(ctx, cfg.LeaderElection, kcpOpts, watch)
+		}
+		return watch(ctx)
+	}
+
+	sync, err := buildSyncFn(cfg, converterCfg.pipeline(cfg.optionalStages()), kcpClient, gardenerClient, opts)
+	if err != nil {
+		return err
+	}
+	return sync(ctx)
+}
+
+// lazyKcpGet builds the KCP client on the first read, so the commands reading from the KCP only with the secret
+// auth method of the Gardener client, e.g. simulate, do not require KCP credentials otherwise.
+func lazyKcpGet(kcpOpts client.Options) seeker.Get {
+	var kcpClient k8sclient.Client
+	return func(ctx context.Context, key k8sclient.ObjectKey, obj k8sclient.Object, opts ...k8sclient.GetOption) error {
+		if kcpClient == nil {
+			var err error
+			if kcpClient, err = client.New(kcpOpts, clientNameKcp); err != nil {
+				return err
+			}
+		}
+		return kcpClient.Get(ctx, key, obj, opts...)
+	}
+}
+
+func withClientLimits(opts client.Options, limits ClientLimits, name string) client.Options {
+	opts.QPS = float32(limits.QPS)
+	opts.Burst = limits.Burst
+	opts.Timeout = mustParseDuration(limits.RequestTimeout)
+	opts.UserAgent = userAgent(name)
+	return opts
+}
+
+// buildSyncFn composes the configured pipeline out of the default stages.
+func buildSyncFn(cfg Config, pipeline seeker.PipelineConfig, kcpClient, gardenerClient k8sclient.Client, opts seeker.SeedOpts) (seeker.Sync, error) {
+	registry, err := newRegistry(cfg, kcpClient, gardenerClient, opts)
+	if err != nil {
+		return nil, err
+	}
+	return registry.Build(pipeline)
+}
+
+// newRegistry registers the default stages, the optional ones only when enabled.
+func newRegistry(cfg Config, kcpClient, gardenerClient k8sclient.Client, opts seeker.SeedOpts) (*seeker.Registry, error) {
+	gardenerTimeout := mustParseDuration(cfg.Gardener.Timeout)
 	store := seeker.BuildStoreFn(seeker.StoreOpts{
 		Key:     cfg.seedMapKey(),
 		Patch:   kcpClient.Patch,
@@ -77,29 +144,97 @@ func Run() error {
 		Timeout: defaultKcpClientTimeout,
 	})
 
-	gardenerClient, err := client.New(
-		client.Options{
-			KubeconfigPath: cfg.Gardener.KubeconfigPath,
-			AdditionalAddToSchema: []func(*runtime.Scheme) error{
-				v1beta1.AddToScheme,
-			},
-		},
-		"gardener",
-	)
+	registry := seeker.NewRegistry()
+	registrations := []error{
+		registry.RegisterSource(seeker.StageGardener, newSource(cfg, gardenerClient)),
+		registry.RegisterFilter(seeker.StageEligibility, seeker.EligibilityFilter(opts)),
+		registry.RegisterTransformer(seeker.StageGroupRegions, seeker.GroupRegionsTransformer),
+		registry.RegisterTransformer(seeker.StageHA, seeker.HATransformer(cfg.Seed.HAMinZones)),
+		registry.RegisterTransformer(seeker.StageLabels, seeker.LabelsTransformer(opts.LabelKeys)),
+		registry.RegisterTransformer(seeker.StageRedundancy, seeker.RedundancyTransformer(opts.Redundancy, opts.Mapping)),
+		registry.RegisterTransformer(seeker.StageMapping, seeker.MappingTransformer(opts.Mapping)),
+		registry.RegisterSink(seeker.StageConfigMap, seeker.StoreSink(store)),
+	}
+	if cfg.Seed.CloudProfileCheck != CloudProfileCheckDisabled {
+		remove := cfg.Seed.CloudProfileCheck == CloudProfileCheckFilter
+		registrations = append(registrations, registry.RegisterFilter(seeker.StageCloudProfile, seeker.CloudProfileFilter(gardenerClient.List, gardenerTimeout, remove)))
+	}
+	if cfg.Stabilization.enabled() {
+		stabilizeOpts := seeker.StabilizeOpts{
+			RemoveAfterRuns: cfg.Stabilization.RemovalRuns,
+			RemoveAfter:     mustParseDuration(cfg.Stabilization.RemovalDelay),
+			AddAfter:        mustParseDuration(cfg.Stabilization.AdditionDelay),
+			Key:             cfg.seedStateMapKey(),
+			Patch:           kcpClient.Patch,
+			Get:             kcpClient.Get,
+			Timeout:         defaultKcpClientTimeout,
+		}
+		registrations = append(registrations,
+			registry.RegisterFilter(seeker.StageStabilize, seeker.StabilizeFilter(seeker.BuildStabilizeFn(stabilizeOpts))),
+			registry.RegisterSink(seeker.StageStabilize, seeker.StabilizeSink(stabilizeOpts)))
+	}
+	if cfg.catalogEnabled() {
+		registrations = append(registrations, registry.RegisterSink(seeker.StageCatalog, seeker.CatalogSink(seeker.CatalogOpts{
+			Timeout: gardenerTimeout,
+			Key:     cfg.regionCatalogMapKey(),
+			Mapping: opts.Mapping,
+			List:    gardenerClient.List,
+			Patch:   kcpClient.Patch,
+		})))
+	}
+	if cfg.networksEnabled() {
+		registrations = append(registrations, registry.RegisterSink(seeker.StageNetworks, seeker.NetworksSink(seeker.NetworksOpts{
+			Timeout: defaultKcpClientTimeout,
+			Key:     cfg.seedNetworksMapKey(),
+			Mapping: opts.Mapping,
+			Patch:   kcpClient.Patch,
+		})))
+	}
+	return registry, errors.Join(registrations...)
+}
+
+// newSource lists the seeds from Gardener, classified as ManagedSeeds if enabled.
+func newSource(cfg Config, gardenerClient k8sclient.Client) seeker.Source {
+	gardenerTimeout := mustParseDuration(cfg.Gardener.Timeout)
+	if cfg.managedSeedsEnabled() {
+		return seeker.ManagedSeedSource(gardenerClient.List, gardenerTimeout)
+	}
+	return seeker.ListSource(gardenerClient.List, gardenerTimeout)
+}
+
+// validateConverterConfig checks the already decoded tolerations against the seeds currently present in Gardener,
+// and, if the cloud profile check is enabled, the seed regions against the cloud profiles.
+// Findings are only reported as warnings, since a toleration may be configured ahead of a seed being created.
+func validateConverterConfig(ctx context.Context, cfg Config, get seeker.Get, opts seeker.SeedOpts) error {
+	defer seeker.LogWithDuration(time.Now(), "converter config validation complete")
 
+	gardenerClient, err := newGardenerClient(cfg, get)
 	if err != nil {
 		return err
 	}
 
-	gardenerTimeout := mustParseDuration(cfg.Gardener.Timeout)
-	fetch := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
-		List:        gardenerClient.List,
-		Timeout:     gardenerTimeout,
-		Tolerations: tolerations,
-	})
+	ctx, cancel := context.WithTimeout(ctx, mustParseDuration(cfg.Gardener.Timeout))
+	defer cancel()
+
+	seeds, err := seeker.ListSeeds(ctx, gardenerClient.List)
+	if err != nil {
+		return err
+	}
+
+	warnings := seeker.ValidateTolerations(opts, seeds.Items)
+	if cfg.Seed.CloudProfileCheck != CloudProfileCheckDisabled {
+		profiles, err := seeker.ListCloudProfiles(ctx, gardenerClient.List)
+		if err != nil {
+			return err
+		}
+		warnings = append(warnings, seeker.ValidateSeedRegions(seeker.NewCloudProfileRegions(profiles.Items), seeds.Items)...)
+	}
+	for _, warning := range warnings {
+		log.Warn("converter config validation", "warning", warning)
+	}
 
-	sync := seeker.BuildSyncFn(store, fetch)
-	return sync()
+	log.Info("converter config is valid", "path", cfg.ConverterConfigFilepath, "warnings", len(warnings))
+	return nil
 }
 
 func mustParseDuration(s string) time.Duration {
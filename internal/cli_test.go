@@ -1,23 +1,47 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io"
+	corev1 "k8s.io/api/core/v1"
 	"os"
+	"path/filepath"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
 	"testing"
 )
 
 const seedsFilePath = "config/test/seeds_minimal.yaml"
 const converterConfigPath = "config/test/converter_config.yaml"
+const converterConfigMinimalPath = "config/test/converter_config_minimal.json"
+const converterConfigUnknownConverterFieldPath = "config/test/converter_config_unknown_converter_field.json"
+const converterConfigUnknownSyncerFieldPath = "config/test/converter_config_unknown_syncer_field.json"
+const converterConfigUnknownTolerationFieldPath = "config/test/converter_config_unknown_toleration_field.json"
+const converterConfigYAMLPath = "config/test/converter_config_yaml.yaml"
+const converterConfigSyncerPath = "config/test/converter_config_syncer.json"
+const converterConfigInvalidSyncerPath = "config/test/converter_config_invalid_syncer.json"
+const converterConfigInvalidLastOperationPath = "config/test/converter_config_invalid_last_operation.json"
+const converterConfigPipelinePath = "config/test/converter_config_pipeline.json"
+const converterConfigPipelineOptionalPath = "config/test/converter_config_pipeline_optional.json"
+const converterConfigPipelineUnknownStagePath = "config/test/converter_config_pipeline_unknown_stage.json"
+const converterConfigPipelineStabilizeOrderPath = "config/test/converter_config_pipeline_stabilize_order.json"
+const converterConfigRulesPath = "config/test/converter_config_rules.json"
+const converterConfigInvalidRulePath = "config/test/converter_config_invalid_rule.json"
+const converterConfigInvalidVersionsPath = "config/test/converter_config_invalid_versions.json"
+const converterConfigSharedProviderTolerationsPath = "config/test/converter_config_shared_provider_tolerations.json"
+const converterConfigInvalidSyncerTolerationsPath = "config/test/converter_config_invalid_syncer_tolerations.json"
 
 func TestMarshalingStubData(t *testing.T) {
 	t.Run("proper marshaling of infrastructure manager config", func(t *testing.T) {
-		converter_config, err := loadConverterConfig(converterConfigPath)
+		converter_config, err := loadConverterConfig(converterConfigPath, seeker.PipelineConfig{})
 		if err != nil {
 			t.Fatalf("failed to load config: %v", err)
 		}
@@ -49,9 +73,135 @@ func TestMarshalingStubData(t *testing.T) {
 	})
 
 	t.Run("error during converter config ", func(t *testing.T) {
-		_, err := loadConverterConfig("non-existing-path.yaml")
+		_, err := loadConverterConfig("non-existing-path.yaml", seeker.PipelineConfig{})
 		require.Error(t, err)
 	})
+
+	t.Run("converter config without syncer section", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigMinimalPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []v1beta1.Toleration{{Key: "configured-taint"}}, converter_config.ConverterConfig.Tolerations["region-central"])
+	})
+
+	t.Run("unknown field in converter section outside tolerations", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigUnknownConverterFieldPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []v1beta1.Toleration{{Key: "configured-taint"}}, converter_config.ConverterConfig.Tolerations["region-central"])
+	})
+
+	t.Run("unknown field in tolerations", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigUnknownTolerationFieldPath, seeker.PipelineConfig{})
+		require.ErrorContains(t, err, `unknown field "kye"`)
+	})
+
+	t.Run("yaml converter config", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigYAMLPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []v1beta1.Toleration{{Key: "configured-taint"}}, converter_config.ConverterConfig.Tolerations["region-central"])
+		require.Equal(t, []string{"environment"}, converter_config.Syncer.LabelKeys)
+	})
+
+	t.Run("unknown field in syncer section", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigUnknownSyncerFieldPath, seeker.PipelineConfig{})
+		require.ErrorContains(t, err, `unknown field "labelKey"`)
+	})
+
+	t.Run("syncer section in converter config", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigSyncerPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Len(t, converter_config.Syncer.RequiredConditions, 3)
+		require.Equal(t, []v1beta1.LastOperationState{"Succeeded", "Processing", "Error"}, converter_config.Syncer.LastOperation.AcceptedStates)
+		require.Equal(t, seeker.VersionOpts{Gardener: ">= 1.110", Kubernetes: ">= 1.30, < 1.34"}, converter_config.Syncer.Versions)
+		require.Equal(t, []string{"environment"}, converter_config.Syncer.LabelKeys)
+		require.Equal(t, config.TolerationsConfig{"aws/*": {{Key: "aws-taint"}}}, converter_config.Syncer.Tolerations)
+	})
+
+	t.Run("provider scoped tolerations in shared section of converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigSharedProviderTolerationsPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, ErrInvalidValue)
+		require.ErrorContains(t, err, `"aws/eu-central-1"`)
+	})
+
+	t.Run("region keyed tolerations in syncer section of converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigInvalidSyncerTolerationsPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, ErrInvalidValue)
+		require.ErrorContains(t, err, `"eu-central-1"`)
+	})
+
+	t.Run("invalid syncer section in converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigInvalidSyncerPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, ErrInvalidValue)
+	})
+
+	t.Run("unknown last operation state in converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigInvalidLastOperationPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, ErrInvalidValue)
+		require.ErrorContains(t, err, `"Done"`)
+	})
+
+	t.Run("invalid version constraint in converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigInvalidVersionsPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, seeker.ErrInvalidVersionConstraint)
+		require.ErrorContains(t, err, `kubernetes version "newer than 1.30"`)
+	})
+
+	t.Run("pipeline in converter config", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigPipelinePath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Equal(t, seeker.DefaultPipelineConfig(seeker.PipelineConfig{}), converter_config.pipeline(seeker.PipelineConfig{}))
+	})
+
+	t.Run("pipeline with enabled optional stage", func(t *testing.T) {
+		optional := seeker.PipelineConfig{Filters: []string{seeker.StageCloudProfile}}
+		converter_config, err := loadConverterConfig(converterConfigPipelineOptionalPath, optional)
+		require.NoError(t, err)
+		require.Equal(t, []string{seeker.StageEligibility, seeker.StageCloudProfile}, converter_config.pipeline(optional).Filters)
+	})
+
+	t.Run("pipeline without enabled optional stage", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigPipelinePath, seeker.PipelineConfig{Filters: []string{seeker.StageStabilize}})
+		require.ErrorIs(t, err, seeker.ErrInvalidPipeline)
+		require.ErrorContains(t, err, `filter "stabilize" is enabled by the program arguments but not listed`)
+	})
+
+	t.Run("pipeline with disabled optional stage", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigPipelineOptionalPath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, seeker.ErrInvalidPipeline)
+		require.ErrorContains(t, err, `filter "cloudprofile" is listed but not enabled by the program arguments`)
+	})
+
+	t.Run("pipeline with unknown stage", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigPipelineUnknownStagePath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, seeker.ErrInvalidPipeline)
+		require.ErrorContains(t, err, `unknown source "gardner"`)
+	})
+
+	t.Run("pipeline with stabilize sink before another sink", func(t *testing.T) {
+		optional := seeker.PipelineConfig{Filters: []string{seeker.StageStabilize}, Sinks: []string{seeker.StageStabilize}}
+		_, err := loadConverterConfig(converterConfigPipelineStabilizeOrderPath, optional)
+		require.ErrorIs(t, err, seeker.ErrInvalidPipeline)
+		require.ErrorContains(t, err, `sink "stabilize" must be the last one`)
+	})
+
+	t.Run("default pipeline", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigMinimalPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		optional := seeker.PipelineConfig{Filters: []string{seeker.StageStabilize}}
+		require.Equal(t, seeker.DefaultPipelineConfig(optional), converter_config.pipeline(optional))
+	})
+
+	t.Run("rules in converter config", func(t *testing.T) {
+		converter_config, err := loadConverterConfig(converterConfigRulesPath, seeker.PipelineConfig{})
+		require.NoError(t, err)
+		require.Len(t, converter_config.rules, 1)
+		require.Equal(t, "not-excluded", converter_config.rules[0].Name)
+	})
+
+	t.Run("invalid rule in converter config", func(t *testing.T) {
+		_, err := loadConverterConfig(converterConfigInvalidRulePath, seeker.PipelineConfig{})
+		require.ErrorIs(t, err, seeker.ErrInvalidSeedRule)
+		require.ErrorContains(t, err, `rule "broken"`)
+	})
 }
 
 func loadSeeds(path string) (seeds v1beta1.SeedList, err error) {
@@ -104,7 +254,7 @@ func TestConfigurationIntegration(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.seedName, func(t *testing.T) {
-			converter_config, _ := loadConverterConfig(converterConfigPath)
+			converter_config, _ := loadConverterConfig(converterConfigPath, seeker.PipelineConfig{})
 			seeds, _ := loadSeeds(seedsFilePath)
 
 			tolerations := converter_config.ConverterConfig.Tolerations
@@ -125,10 +275,45 @@ func TestConfigurationIntegration(t *testing.T) {
 					if item.Spec.Taints != nil {
 						assert.Equal(t, testCase.expectedTaintMatched, seeker.TaintMatched(item.Spec.Taints[0], tolerations[region]))
 					}
-					assert.Equal(t, testCase.expectedReadiness, seeker.VerifySeedReadiness(&item))
-					assert.Equal(t, testCase.expectedSeedCanBeUsed, seeker.SeedCanBeUsed(&item, tolerations))
+					assert.Equal(t, testCase.expectedReadiness, seeker.VerifySeedReadiness(&item, seeker.SeedOpts{}))
+					assert.Equal(t, testCase.expectedSeedCanBeUsed, seeker.SeedCanBeUsed(&item, seeker.SeedOpts{Tolerations: tolerations}))
 				}
 			}
 		})
 	}
 }
+
+func TestLazyKcpGet(t *testing.T) {
+	// GIVEN
+	kcpOpts := client.Options{KubeconfigPath: filepath.Join(t.TempDir(), "missing-kubeconfig")}
+
+	// WHEN
+	get := lazyKcpGet(kcpOpts)
+
+	// THEN the client is built, and fails, only on the first read
+	require.NotNil(t, get)
+	err := get(context.Background(), k8sclient.ObjectKey{Name: "test"}, &corev1.Secret{})
+	require.Error(t, err)
+}
+
+func TestStageNamesRegistered(t *testing.T) {
+	// GIVEN all optional stages enabled
+	cfg := Config{
+		Gardener: Gardener{
+			Timeout:              "1s",
+			RegionCatalogMapName: "test-catalog",
+			SeedNetworksMapName:  "test-networks",
+		},
+		Seed:          Seed{CloudProfileCheck: CloudProfileCheckFilter},
+		Stabilization: Stabilization{RemovalRuns: 1, RemovalDelay: "0s", AdditionDelay: "0s"},
+	}
+	fakeClient := fake.NewClientBuilder().Build()
+	registry, err := newRegistry(cfg, fakeClient, fakeClient, seeker.SeedOpts{})
+	require.NoError(t, err)
+
+	// WHEN
+	_, err = registry.Build(stageNames)
+
+	// THEN
+	require.NoError(t, err)
+}
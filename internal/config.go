@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,16 +12,77 @@ import (
 )
 
 type Gardener struct {
-	KubeconfigPath   string
-	Timeout          string
-	SeedMapName      string
-	SeedMapNamespace string
+	AuthMethod                string
+	KubeconfigPath            string
+	KubeconfigSecretName      string
+	KubeconfigSecretNamespace string
+	KubeconfigSecretKey       string
+	Host                      string
+	TokenPath                 string
+	CAPath                    string
+	Timeout                   string
+	SeedMapName               string
+	SeedMapNamespace          string
+	RegionCatalogMapName      string
+	SeedNetworksMapName       string
+	Client                    ClientLimits
+}
+
+type ClientLimits struct {
+	QPS            float64
+	Burst          int
+	RequestTimeout string
+}
+
+type Kcp struct {
+	Client ClientLimits
+}
+
+type LeaderElection struct {
+	Enabled       bool
+	Namespace     string
+	Name          string
+	LeaseDuration string
+	RenewDeadline string
+	RetryPeriod   string
+}
+
+type Seed struct {
+	MaxConditionAge    string
+	MaxGenerationLag   int
+	GenerationLagGrace string
+	CloudProfileCheck  string
+	ManagedSeeds       string
+	HAMinZones         int
+}
+
+type Stabilization struct {
+	StateMapName  string
+	RemovalRuns   int
+	RemovalDelay  string
+	AdditionDelay string
+}
+
+func (s Stabilization) enabled() bool {
+	return s.RemovalRuns > 0 || mustParseDuration(s.RemovalDelay) > 0 || mustParseDuration(s.AdditionDelay) > 0
+}
+
+type Watch struct {
+	SyncInterval   string
+	ReloadInterval string
 }
 
 type Config struct {
 	Gardener                Gardener
+	Kcp                     Kcp
+	Watch                   Watch
+	LeaderElection          LeaderElection
+	Stabilization           Stabilization
+	Seed                    Seed
 	LogLevel                string
 	ConverterConfigFilepath string
+	SimulateShootPath       string
+	Command                 string
 }
 
 func (c *Config) seedMapKey() client.ObjectKey {
@@ -30,6 +92,46 @@ func (c *Config) seedMapKey() client.ObjectKey {
 	}
 }
 
+func (c *Config) seedStateMapKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: c.Gardener.SeedMapNamespace,
+		Name:      c.Stabilization.StateMapName,
+	}
+}
+
+func (c *Config) regionCatalogMapKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: c.Gardener.SeedMapNamespace,
+		Name:      c.Gardener.RegionCatalogMapName,
+	}
+}
+
+func (c *Config) managedSeedsEnabled() bool {
+	return c.Seed.ManagedSeeds != ManagedSeedsDisabled
+}
+
+func (c *Config) catalogEnabled() bool {
+	return c.Gardener.RegionCatalogMapName != ""
+}
+
+func (c *Config) seedNetworksMapKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: c.Gardener.SeedMapNamespace,
+		Name:      c.Gardener.SeedNetworksMapName,
+	}
+}
+
+func (c *Config) networksEnabled() bool {
+	return c.Gardener.SeedNetworksMapName != ""
+}
+
+func (c *Config) kubeconfigSecretKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: c.Gardener.KubeconfigSecretNamespace,
+		Name:      c.Gardener.KubeconfigSecretName,
+	}
+}
+
 var ErrInvalidValue = fmt.Errorf("invalid value")
 
 func validate[T any](value T, rulez []func(T) bool) error {
@@ -45,6 +147,18 @@ func isNotEmpty(s string) bool {
 	return s != ""
 }
 
+func isPositive[T int | float64](value T) bool {
+	return value > 0
+}
+
+func isNotNegative(value int) bool {
+	return value >= 0
+}
+
+func isValidGenerationLag(value int) bool {
+	return value >= FlagDefaultSeedMaxGenerationLag
+}
+
 func isValidDuration(s string) bool {
 	_, err := time.ParseDuration(s)
 	return err == nil
@@ -55,6 +169,22 @@ func isValidLogLevel(s string) bool {
 	return found
 }
 
+func isValidCommand(s string) bool {
+	return slices.Contains(commands, s)
+}
+
+func isValidAuthMethod(s string) bool {
+	return slices.Contains(authMethods, s)
+}
+
+func isValidCloudProfileCheck(s string) bool {
+	return slices.Contains(cloudProfileChecks, s)
+}
+
+func isValidManagedSeeds(s string) bool {
+	return slices.Contains(managedSeedsModes, s)
+}
+
 func (c *Config) Validate() error {
 	for _, item := range []struct {
 		fieldValues []string
@@ -65,12 +195,24 @@ func (c *Config) Validate() error {
 				c.Gardener.KubeconfigPath,
 				c.Gardener.SeedMapName,
 				c.Gardener.SeedMapNamespace,
+				c.Stabilization.StateMapName,
 			},
 			validators: []func(string) bool{isNotEmpty},
 		},
 		{
 			fieldValues: []string{
 				c.Gardener.Timeout,
+				c.Gardener.Client.RequestTimeout,
+				c.Kcp.Client.RequestTimeout,
+				c.Watch.SyncInterval,
+				c.Watch.ReloadInterval,
+				c.LeaderElection.LeaseDuration,
+				c.LeaderElection.RenewDeadline,
+				c.LeaderElection.RetryPeriod,
+				c.Stabilization.RemovalDelay,
+				c.Stabilization.AdditionDelay,
+				c.Seed.MaxConditionAge,
+				c.Seed.GenerationLagGrace,
 			},
 			validators: []func(string) bool{isValidDuration},
 		},
@@ -80,6 +222,30 @@ func (c *Config) Validate() error {
 			},
 			validators: []func(string) bool{isValidLogLevel},
 		},
+		{
+			fieldValues: []string{
+				c.Command,
+			},
+			validators: []func(string) bool{isValidCommand},
+		},
+		{
+			fieldValues: []string{
+				c.Gardener.AuthMethod,
+			},
+			validators: []func(string) bool{isValidAuthMethod},
+		},
+		{
+			fieldValues: []string{
+				c.Seed.CloudProfileCheck,
+			},
+			validators: []func(string) bool{isValidCloudProfileCheck},
+		},
+		{
+			fieldValues: []string{
+				c.Seed.ManagedSeeds,
+			},
+			validators: []func(string) bool{isValidManagedSeeds},
+		},
 	} {
 		for _, isValid := range item.validators {
 			for _, value := range item.fieldValues {
@@ -90,22 +256,193 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if err := validate(c.Stabilization.RemovalRuns, []func(int) bool{isNotNegative}); err != nil {
+		return err
+	}
+
+	if err := validate(c.Seed.HAMinZones, []func(int) bool{isNotNegative}); err != nil {
+		return err
+	}
+
+	if err := validate(c.Seed.MaxGenerationLag, []func(int) bool{isValidGenerationLag}); err != nil {
+		return err
+	}
+
+	for _, limits := range []ClientLimits{c.Kcp.Client, c.Gardener.Client} {
+		if err := validate(limits.QPS, []func(float64) bool{isPositive}); err != nil {
+			return err
+		}
+		if err := validate(limits.Burst, []func(int) bool{isPositive}); err != nil {
+			return err
+		}
+	}
+
+	if err := c.validateLeaderElection(); err != nil {
+		return err
+	}
+
+	if c.Command == CommandSimulate && c.SimulateShootPath == "" {
+		return fmt.Errorf("%w: the %s command requires %s", ErrInvalidValue, CommandSimulate, FlagNameSimulateShootPath)
+	}
+
+	return c.validateAuthMethod()
+}
+
+func (c *Config) validateLeaderElection() error {
+	if !c.LeaderElection.Enabled {
+		return nil
+	}
+
+	if c.Command != CommandWatch {
+		return fmt.Errorf("%w: leader election requires the %s command", ErrInvalidValue, CommandWatch)
+	}
+
+	for _, value := range []string{c.LeaderElection.Namespace, c.LeaderElection.Name} {
+		if err := validate(value, []func(string) bool{isNotEmpty}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (c *Config) validateAuthMethod() error {
+	var required []string
+	switch c.Gardener.AuthMethod {
+	case AuthMethodToken:
+		required = []string{c.Gardener.Host, c.Gardener.TokenPath}
+	case AuthMethodSecret:
+		required = []string{c.Gardener.KubeconfigSecretName, c.Gardener.KubeconfigSecretNamespace, c.Gardener.KubeconfigSecretKey}
+	}
+
+	for _, value := range required {
+		if err := validate(value, []func(string) bool{isNotEmpty}); err != nil {
+			return fmt.Errorf("%w: required by gardener auth method %s", err, c.Gardener.AuthMethod)
+		}
+	}
+	return nil
+}
+
+const (
+	CommandSimulate       = "simulate"
+	CommandSync           = "sync"
+	CommandValidateConfig = "validate-config"
+	CommandWatch          = "watch"
+)
+
+var commands = []string{
+	CommandSimulate,
+	CommandSync,
+	CommandValidateConfig,
+	CommandWatch,
+}
+
+const (
+	AuthMethodKubeconfig = "kubeconfig"
+	AuthMethodSecret     = "secret"
+	AuthMethodToken      = "token"
+)
+
+var authMethods = []string{
+	AuthMethodKubeconfig,
+	AuthMethodSecret,
+	AuthMethodToken,
+}
+
+const (
+	CloudProfileCheckDisabled = "disabled"
+	CloudProfileCheckReport   = "report"
+	CloudProfileCheckFilter   = "filter"
+)
+
+var cloudProfileChecks = []string{
+	CloudProfileCheckDisabled,
+	CloudProfileCheckReport,
+	CloudProfileCheckFilter,
+}
+
+const (
+	ManagedSeedsDisabled = "disabled"
+	ManagedSeedsReport   = "report"
+	ManagedSeedsOnly     = "only"
+	ManagedSeedsExclude  = "exclude"
+)
+
+var managedSeedsModes = []string{
+	ManagedSeedsDisabled,
+	ManagedSeedsReport,
+	ManagedSeedsOnly,
+	ManagedSeedsExclude,
+}
+
 const (
 	FlagDefaultConverterConfigPath            = "/converter-config/converter_config.json"
+	FlagDefaultGardenerAuthMethod             = AuthMethodKubeconfig
 	FlagDefaultGardenerKubeconfigPath         = "/gardener/kubeconfig"
+	FlagDefaultGardenerKubeconfigSecretKey    = "kubeconfig"
+	FlagDefaultGardenerKubeconfigSecretNs     = "kcp-system"
 	FlagDefaultGardenerSeedConfigMapName      = "gardener-seeds-cache"
 	FlagDefaultGardenerSeedConfigMapNamespace = "kcp-system"
+	FlagDefaultClientBurst                    = 30
+	FlagDefaultClientQPS                      = 20
+	FlagDefaultClientRequestTimeout           = "30s"
 	FlagDefaultGardenerTimeout                = "10s"
+	FlagDefaultGardenerTokenPath              = "/var/run/secrets/gardener/token"
+	FlagDefaultLeaderElectionLeaseDuration    = "15s"
+	FlagDefaultLeaderElectionName             = "gardener-syncer"
+	FlagDefaultLeaderElectionNamespace        = "kcp-system"
+	FlagDefaultLeaderElectionRenewDeadline    = "10s"
+	FlagDefaultLeaderElectionRetryPeriod      = "2s"
 	FlagDefaultLogLevel                       = "INFO"
+	FlagDefaultSeedAdditionDelay              = "0s"
+	FlagDefaultSeedCloudProfileCheck          = CloudProfileCheckDisabled
+	FlagDefaultSeedManagedSeeds               = ManagedSeedsDisabled
+	FlagDefaultSeedMaxConditionAge            = "0s"
+	FlagDefaultSeedMaxGenerationLag           = -1
+	FlagDefaultSeedGenerationLagGrace         = "5m"
+	FlagDefaultSeedRemovalDelay               = "0s"
+	FlagDefaultSeedStateMapName               = "gardener-seeds-cache-state"
+	FlagDefaultWatchReloadInterval            = "30s"
+	FlagDefaultWatchSyncInterval              = "10m"
 	FlagNameConverterConfigPath               = "converter-config-filepath"
+	FlagNameGardenerAuthMethod                = "gardener-auth-method"
+	FlagNameGardenerBurst                     = "gardener-burst"
+	FlagNameGardenerCAPath                    = "gardener-ca-path"
+	FlagNameGardenerHost                      = "gardener-host"
 	FlagNameGardenerKubeconfigPath            = "gardener-kubeconfig-path"
+	FlagNameGardenerKubeconfigSecretKey       = "gardener-kubeconfig-secret-key"
+	FlagNameGardenerKubeconfigSecretName      = "gardener-kubeconfig-secret-name"
+	FlagNameGardenerKubeconfigSecretNs        = "gardener-kubeconfig-secret-namespace"
+	FlagNameGardenerQPS                       = "gardener-qps"
+	FlagNameGardenerRequestTimeout            = "gardener-request-timeout"
 	FlagNameGardenerSeedConfigMapName         = "gardener-seed-map-name"
 	FlagNameGardenerSeedConfigMapNamespace    = "gardener-seed-map-namespace"
+	FlagNameGardenerSeedNetworksMapName       = "gardener-seed-networks-map-name"
+	FlagNameGardenerRegionCatalogMapName      = "gardener-region-catalog-map-name"
 	FlagNameGardenerTimeout                   = "gardener-timeout"
+	FlagNameGardenerTokenPath                 = "gardener-token-path"
+	FlagNameKcpBurst                          = "kcp-burst"
+	FlagNameKcpQPS                            = "kcp-qps"
+	FlagNameKcpRequestTimeout                 = "kcp-request-timeout"
+	FlagNameLeaderElect                       = "leader-elect"
+	FlagNameLeaderElectionLeaseDuration       = "leader-election-lease-duration"
+	FlagNameLeaderElectionName                = "leader-election-name"
+	FlagNameLeaderElectionNamespace           = "leader-election-namespace"
+	FlagNameLeaderElectionRenewDeadline       = "leader-election-renew-deadline"
+	FlagNameLeaderElectionRetryPeriod         = "leader-election-retry-period"
 	FlagNameLogLevel                          = "log-level"
+	FlagNameSeedAdditionDelay                 = "seed-addition-delay"
+	FlagNameSeedCloudProfileCheck             = "seed-cloud-profile-check"
+	FlagNameSeedHAMinZones                    = "seed-ha-min-zones"
+	FlagNameSeedManagedSeeds                  = "seed-managed-seeds"
+	FlagNameSeedMaxConditionAge               = "seed-max-condition-age"
+	FlagNameSeedMaxGenerationLag              = "seed-max-generation-lag"
+	FlagNameSeedGenerationLagGrace            = "seed-generation-lag-grace"
+	FlagNameSeedRemovalDelay                  = "seed-removal-delay"
+	FlagNameSeedRemovalRuns                   = "seed-removal-runs"
+	FlagNameSeedStateMapName                  = "gardener-seed-state-map-name"
+	FlagNameSimulateShootPath                 = "simulate-shoot-path"
+	FlagNameWatchReloadInterval               = "reload-interval"
+	FlagNameWatchSyncInterval                 = "sync-interval"
 )
 
 func logLevelMappingKeys() []string {
@@ -119,15 +456,61 @@ func logLevelMappingKeys() []string {
 func NewConfigFromFlags() (Config, error) {
 	out := Config{}
 
+	flag.StringVar(&out.Gardener.AuthMethod, FlagNameGardenerAuthMethod, FlagDefaultGardenerAuthMethod, fmt.Sprintf("Gardener authentication method, one of: %s", strings.Join(authMethods, ",")))
 	flag.StringVar(&out.Gardener.KubeconfigPath, FlagNameGardenerKubeconfigPath, FlagDefaultGardenerKubeconfigPath, "A path to gardener kubeconfig file.")
+	flag.StringVar(&out.Gardener.KubeconfigSecretName, FlagNameGardenerKubeconfigSecretName, "", "The name of the KCP secret holding the gardener kubeconfig, used by the secret auth method.")
+	flag.StringVar(&out.Gardener.KubeconfigSecretNamespace, FlagNameGardenerKubeconfigSecretNs, FlagDefaultGardenerKubeconfigSecretNs, "The namespace of the KCP secret holding the gardener kubeconfig, used by the secret auth method.")
+	flag.StringVar(&out.Gardener.KubeconfigSecretKey, FlagNameGardenerKubeconfigSecretKey, FlagDefaultGardenerKubeconfigSecretKey, "The key of the gardener kubeconfig in the KCP secret, used by the secret auth method.")
+	flag.StringVar(&out.Gardener.Host, FlagNameGardenerHost, "", "Gardener API server URL, used by the token auth method.")
+	flag.StringVar(&out.Gardener.TokenPath, FlagNameGardenerTokenPath, FlagDefaultGardenerTokenPath, "A path to the projected gardener service account token file, used by the token auth method.")
+	flag.StringVar(&out.Gardener.CAPath, FlagNameGardenerCAPath, "", "A path to the gardener API server CA bundle, used by the token auth method.")
 	flag.StringVar(&out.Gardener.SeedMapName, FlagNameGardenerSeedConfigMapName, FlagDefaultGardenerSeedConfigMapName, "The name of the config-map that will store gardener seeds.")
 	flag.StringVar(&out.Gardener.SeedMapNamespace, FlagNameGardenerSeedConfigMapNamespace, FlagDefaultGardenerSeedConfigMapNamespace, "The namespace of the config-map that will store gardener seeds.")
+	flag.StringVar(&out.Gardener.RegionCatalogMapName, FlagNameGardenerRegionCatalogMapName, "", "The name of the config-map that will store the region catalog built from the cloud profiles, stored in the seed map namespace. Empty disables the catalog.")
+	flag.StringVar(&out.Gardener.SeedNetworksMapName, FlagNameGardenerSeedNetworksMapName, "", "The name of the config-map that will store the network ranges of the usable seeds, stored in the seed map namespace. Empty disables the seed networks.")
 	flag.StringVar(&out.Gardener.Timeout, FlagNameGardenerTimeout, FlagDefaultGardenerTimeout, "Gardener client timeout duration.")
+	flag.Float64Var(&out.Gardener.Client.QPS, FlagNameGardenerQPS, FlagDefaultClientQPS, "Maximum queries per second of the gardener client.")
+	flag.IntVar(&out.Gardener.Client.Burst, FlagNameGardenerBurst, FlagDefaultClientBurst, "Maximum burst of the gardener client.")
+	flag.StringVar(&out.Gardener.Client.RequestTimeout, FlagNameGardenerRequestTimeout, FlagDefaultClientRequestTimeout, "Timeout of a single gardener client request.")
+	flag.Float64Var(&out.Kcp.Client.QPS, FlagNameKcpQPS, FlagDefaultClientQPS, "Maximum queries per second of the KCP client.")
+	flag.IntVar(&out.Kcp.Client.Burst, FlagNameKcpBurst, FlagDefaultClientBurst, "Maximum burst of the KCP client.")
+	flag.StringVar(&out.Kcp.Client.RequestTimeout, FlagNameKcpRequestTimeout, FlagDefaultClientRequestTimeout, "Timeout of a single KCP client request.")
 	flag.StringVar(&out.ConverterConfigFilepath, FlagNameConverterConfigPath, FlagDefaultConverterConfigPath, "File path to the gardener shoot converter configuration.")
+	flag.StringVar(&out.SimulateShootPath, FlagNameSimulateShootPath, "", "File path to the shoot placement input of the simulate command.")
+	flag.StringVar(&out.Watch.SyncInterval, FlagNameWatchSyncInterval, FlagDefaultWatchSyncInterval, "Interval between synchronisations in the watch command.")
+	flag.StringVar(&out.Watch.ReloadInterval, FlagNameWatchReloadInterval, FlagDefaultWatchReloadInterval, "Interval of checking the converter config and Gardener kubeconfig files for changes in the watch command.")
+	flag.BoolVar(&out.LeaderElection.Enabled, FlagNameLeaderElect, false, "Enable leader election on the KCP cluster, so only one replica synchronises at a time in the watch command.")
+	flag.StringVar(&out.LeaderElection.Namespace, FlagNameLeaderElectionNamespace, FlagDefaultLeaderElectionNamespace, "The namespace of the leader election lease.")
+	flag.StringVar(&out.LeaderElection.Name, FlagNameLeaderElectionName, FlagDefaultLeaderElectionName, "The name of the leader election lease.")
+	flag.StringVar(&out.LeaderElection.LeaseDuration, FlagNameLeaderElectionLeaseDuration, FlagDefaultLeaderElectionLeaseDuration, "Duration non-leader candidates wait before forcing to acquire the leadership.")
+	flag.StringVar(&out.LeaderElection.RenewDeadline, FlagNameLeaderElectionRenewDeadline, FlagDefaultLeaderElectionRenewDeadline, "Duration the leader retries refreshing the leadership before giving it up.")
+	flag.StringVar(&out.LeaderElection.RetryPeriod, FlagNameLeaderElectionRetryPeriod, FlagDefaultLeaderElectionRetryPeriod, "Duration candidates wait between tries of acquiring or renewing the leadership.")
+	flag.StringVar(&out.Stabilization.StateMapName, FlagNameSeedStateMapName, FlagDefaultSeedStateMapName, "The name of the config-map that remembers seed eligibility between synchronisations, stored in the seed map namespace.")
+	flag.IntVar(&out.Stabilization.RemovalRuns, FlagNameSeedRemovalRuns, 0, "Number of consecutive synchronisations a seed has to be unusable before its region is removed, 0 disables the check.")
+	flag.StringVar(&out.Stabilization.RemovalDelay, FlagNameSeedRemovalDelay, FlagDefaultSeedRemovalDelay, "Duration a seed has to be unusable before its region is removed, 0 disables the check.")
+	flag.StringVar(&out.Stabilization.AdditionDelay, FlagNameSeedAdditionDelay, FlagDefaultSeedAdditionDelay, "Duration a new seed has to be usable before its region is added.")
+	flag.StringVar(&out.Seed.MaxConditionAge, FlagNameSeedMaxConditionAge, FlagDefaultSeedMaxConditionAge, "Maximum age of the required seed conditions, seeds with an older required condition are treated as not ready, 0 disables the check.")
+	flag.IntVar(&out.Seed.MaxGenerationLag, FlagNameSeedMaxGenerationLag, FlagDefaultSeedMaxGenerationLag, "Maximum number of generations the seed status may lag behind the seed spec, seeds lagging more for longer than the grace period are treated as not ready, -1 disables the check.")
+	flag.StringVar(&out.Seed.GenerationLagGrace, FlagNameSeedGenerationLagGrace, FlagDefaultSeedGenerationLagGrace, "Duration the gardenlet has to reconcile a changed seed spec before the generation lag check applies.")
+	flag.StringVar(&out.Seed.CloudProfileCheck, FlagNameSeedCloudProfileCheck, FlagDefaultSeedCloudProfileCheck, fmt.Sprintf("Check of the seed regions against the regions offered by the cloud profiles, one of: %s", strings.Join(cloudProfileChecks, ",")))
+	flag.StringVar(&out.Seed.ManagedSeeds, FlagNameSeedManagedSeeds, FlagDefaultSeedManagedSeeds, fmt.Sprintf("Classification of the seeds as ManagedSeeds, which requires listing them, and the seeds used by it, one of: %s", strings.Join(managedSeedsModes, ",")))
+	flag.IntVar(&out.Seed.HAMinZones, FlagNameSeedHAMinZones, 0, "Minimum number of zones of a seed for its region to be published as capable of highly-available control planes, 0 disables the capability.")
 	flag.StringVar(&out.LogLevel, FlagNameLogLevel, FlagDefaultLogLevel, fmt.Sprintf("One of: %s", strings.Join(logLevelMappingKeys(), ",")))
 
 	flag.Parse()
 
+	out.Command = CommandSync
+	if flag.NArg() > 0 {
+		out.Command = flag.Arg(0)
+		// flags are also accepted after the command name
+		if err := flag.CommandLine.Parse(flag.Args()[1:]); err != nil {
+			return Config{}, err
+		}
+		if flag.NArg() > 0 {
+			return Config{}, fmt.Errorf("%w: unexpected arguments %v", ErrInvalidValue, flag.Args())
+		}
+	}
+
 	if err := out.Validate(); err != nil {
 		return Config{}, err
 	}
@@ -136,7 +519,7 @@ func NewConfigFromFlags() (Config, error) {
 	flag.VisitAll(func(f *flag.Flag) {
 		flags = append(flags, f.Name, f.Value.String())
 	})
-	slog.Info("configuration parsed", flags...)
+	slog.Info("configuration parsed", append(flags, "command", out.Command)...)
 
 	return out, nil
 }
@@ -35,6 +35,142 @@ func TestNewConfigFromFlags(t *testing.T) {
 				fmt.Sprintf("-%s", cli.FlagNameGardenerKubeconfigPath), "config.go",
 			},
 		},
+		{
+			name: "OK3: validate-config command",
+			args: []string{
+				cli.CommandValidateConfig,
+			},
+			expectedCfg: cli.Config{Command: cli.CommandValidateConfig},
+		},
+		{
+			name: "OK4: flags after command",
+			args: []string{
+				cli.CommandValidateConfig,
+				fmt.Sprintf("-%s", cli.FlagNameLogLevel), "DEBUG",
+			},
+			expectedCfg: cli.Config{Command: cli.CommandValidateConfig},
+		},
+		{
+			name: "ERR1: unknown command",
+			args: []string{
+				"unknown",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR2: unexpected arguments",
+			args: []string{
+				cli.CommandSync, "unexpected",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "OK5: token auth method",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerAuthMethod), cli.AuthMethodToken,
+				fmt.Sprintf("-%s", cli.FlagNameGardenerHost), "https://api.gardener",
+			},
+		},
+		{
+			name: "ERR3: token auth method without host",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerAuthMethod), cli.AuthMethodToken,
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR4: secret auth method without secret name",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerAuthMethod), cli.AuthMethodSecret,
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR5: unknown auth method",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerAuthMethod), "unknown",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR6: zero gardener qps",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerQPS), "0",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "OK6: leader election in watch command",
+			args: []string{
+				cli.CommandWatch,
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElect),
+			},
+			expectedCfg: cli.Config{Command: cli.CommandWatch},
+		},
+		{
+			name: "OK7: simulate command",
+			args: []string{
+				cli.CommandSimulate,
+				fmt.Sprintf("-%s", cli.FlagNameSimulateShootPath), "shoot.yaml",
+			},
+			expectedCfg: cli.Config{Command: cli.CommandSimulate},
+		},
+		{
+			name: "ERR13: simulate command without shoot",
+			args: []string{
+				cli.CommandSimulate,
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR8: leader election in sync command",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElect),
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR9: invalid seed generation lag",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameSeedMaxGenerationLag), "-2",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR14: invalid seed generation lag grace",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameSeedGenerationLagGrace), "5",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR10: invalid cloud profile check",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameSeedCloudProfileCheck), "strict",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR11: negative seed HA zones",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameSeedHAMinZones), "-1",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR12: invalid managed seeds mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameSeedManagedSeeds), "shooted",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR7: invalid kcp request timeout",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameKcpRequestTimeout), "soon",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -44,13 +180,18 @@ func TestNewConfigFromFlags(t *testing.T) {
 			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 
 			// WHEN
-			_, err := cli.NewConfigFromFlags()
+			cfg, err := cli.NewConfigFromFlags()
 
 			// THEN
 			if testCase.expectedError == nil {
 				require.NoError(t, err)
 			}
 
+			// THEN
+			if testCase.expectedCfg.Command != "" {
+				require.Equal(t, testCase.expectedCfg.Command, cfg.Command)
+			}
+
 			// THEN
 			if testCase.expectedError != nil {
 				require.ErrorIs(t, err, testCase.expectedError)
@@ -0,0 +1,291 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"sigs.k8s.io/yaml"
+)
+
+// converterConfig is the infrastructure-manager converter config extended with the syncer section,
+// which the infrastructure-manager ignores.
+type converterConfig struct {
+	config.Config
+	Syncer syncerConfig `json:"syncer"`
+	// rules are the compiled Syncer.Rules.
+	rules []seeker.CompiledSeedRule
+}
+
+type syncerConfig struct {
+	// RequiredConditions defaults to seeker.DefaultRequiredConditions.
+	RequiredConditions []seeker.RequiredCondition `json:"requiredConditions,omitempty"`
+	// LastOperation defaults to seeker.DefaultAcceptedLastOperationStates and seeker.DefaultRejectedLastOperationTypes.
+	LastOperation seeker.LastOperationOpts `json:"lastOperation,omitempty"`
+	// Rules are custom seed eligibility rules written in CEL.
+	Rules []seeker.SeedRule `json:"rules,omitempty"`
+	// Versions are semver constraints of the gardenlet and seed Kubernetes versions.
+	Versions seeker.VersionOpts `json:"versions,omitempty"`
+	// LabelKeys are the keys of the seed labels whose values are published per region.
+	LabelKeys  []string              `json:"labelKeys,omitempty"`
+	Redundancy seeker.RedundancyOpts `json:"redundancy,omitempty"`
+	// Mapping renames the providers and regions to the Kyma platform names.
+	Mapping seeker.MappingOpts `json:"mapping,omitempty"`
+	// Tolerations are keyed by provider type and region, `<provider>/<region>`, or by provider type only, `<provider>/*`.
+	// They are known to the syncer only, so the region keyed tolerations stay in the shared converter section.
+	Tolerations config.TolerationsConfig `json:"tolerations,omitempty"`
+	// Pipeline defaults to seeker.DefaultPipelineConfig.
+	Pipeline *seeker.PipelineConfig `json:"pipeline,omitempty"`
+}
+
+var (
+	lastOperationStates = []gardener_types.LastOperationState{
+		gardener_types.LastOperationStateProcessing,
+		gardener_types.LastOperationStateSucceeded,
+		gardener_types.LastOperationStateError,
+		gardener_types.LastOperationStateFailed,
+		gardener_types.LastOperationStatePending,
+		gardener_types.LastOperationStateAborted,
+	}
+	lastOperationTypes = []gardener_types.LastOperationType{
+		gardener_types.LastOperationTypeCreate,
+		gardener_types.LastOperationTypeReconcile,
+		gardener_types.LastOperationTypeDelete,
+		gardener_types.LastOperationTypeMigrate,
+		gardener_types.LastOperationTypeRestore,
+	}
+)
+
+func (c syncerConfig) validate() error {
+	for _, required := range c.RequiredConditions {
+		if required.Type == "" {
+			return fmt.Errorf("%w: required condition without type", ErrInvalidValue)
+		}
+	}
+	if c.Redundancy.MinSeeds < 0 {
+		return fmt.Errorf("%w: negative minimum number of seeds", ErrInvalidValue)
+	}
+	for provider, minSeeds := range c.Redundancy.Providers {
+		if minSeeds < 0 {
+			return fmt.Errorf("%w: negative minimum number of seeds for provider %s", ErrInvalidValue, provider)
+		}
+	}
+	for provider, mapping := range c.Mapping.Providers {
+		for region, mapped := range mapping.Regions {
+			if mapped == "" {
+				return fmt.Errorf("%w: empty mapping of region %s of provider %s", ErrInvalidValue, region, provider)
+			}
+		}
+	}
+	for _, state := range c.LastOperation.AcceptedStates {
+		if !slices.Contains(lastOperationStates, state) {
+			return fmt.Errorf("%w: unknown last operation state %q", ErrInvalidValue, state)
+		}
+	}
+	for _, opType := range c.LastOperation.RejectedTypes {
+		if !slices.Contains(lastOperationTypes, opType) {
+			return fmt.Errorf("%w: unknown last operation type %q", ErrInvalidValue, opType)
+		}
+	}
+	for _, key := range c.LabelKeys {
+		if key == "" {
+			return fmt.Errorf("%w: empty seed label key", ErrInvalidValue)
+		}
+	}
+	for key := range c.Tolerations {
+		provider, region, found := strings.Cut(key, "/")
+		if !found || provider == "" || region == "" {
+			return fmt.Errorf("%w: tolerations key %q not scoped by provider type", ErrInvalidValue, key)
+		}
+	}
+	if err := c.Versions.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+	}
+	return nil
+}
+
+// loadConverterConfig decodes the converter config file, in JSON or YAML.
+// Unknown fields of the tolerations and of the syncer section are rejected, so typos do not silently result in an empty configuration.
+// An explicit pipeline has to list exactly the optional stages enabled by the program arguments.
+func loadConverterConfig(path string, optional seeker.PipelineConfig) (cfg converterConfig, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("unable to open tolerations config file %s: %w", path, err)
+	}
+
+	if data, err = yaml.YAMLToJSON(data); err != nil {
+		return cfg, fmt.Errorf("unable to decode tolerations config file %s: %w", path, err)
+	}
+
+	// the rest of the converter section belongs to the infrastructure-manager, which may add fields the syncer does not know yet
+	var sections struct {
+		Converter struct {
+			Tolerations json.RawMessage `json:"tolerations"`
+		} `json:"converter"`
+		Syncer json.RawMessage `json:"syncer"`
+	}
+	if err = json.Unmarshal(data, &sections); err != nil {
+		return cfg, fmt.Errorf("unable to decode tolerations config file %s: %w", path, err)
+	}
+
+	if err = json.Unmarshal(data, &cfg.Config); err != nil {
+		return cfg, fmt.Errorf("unable to decode tolerations config file %s: %w", path, err)
+	}
+
+	if len(sections.Converter.Tolerations) > 0 {
+		cfg.ConverterConfig.Tolerations = nil
+		if err = decodeStrict(sections.Converter.Tolerations, &cfg.ConverterConfig.Tolerations); err != nil {
+			return cfg, fmt.Errorf("unable to decode tolerations in config file %s: %w", path, err)
+		}
+	}
+
+	if len(sections.Syncer) > 0 {
+		if err = decodeStrict(sections.Syncer, &cfg.Syncer); err != nil {
+			return cfg, fmt.Errorf("unable to decode syncer section in config file %s: %w", path, err)
+		}
+	}
+
+	if err = validateSharedTolerations(cfg.ConverterConfig.Tolerations); err != nil {
+		return cfg, fmt.Errorf("invalid converter section in config file %s: %w", path, err)
+	}
+
+	if err = cfg.Syncer.validate(); err != nil {
+		return cfg, fmt.Errorf("invalid syncer section in config file %s: %w", path, err)
+	}
+
+	if err = cfg.Syncer.validatePipeline(optional); err != nil {
+		return cfg, fmt.Errorf("invalid syncer section in config file %s: %w", path, err)
+	}
+
+	if cfg.rules, err = seeker.CompileSeedRules(cfg.Syncer.Rules); err != nil {
+		return cfg, fmt.Errorf("invalid syncer section in config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func decodeStrict(data []byte, out any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(out)
+}
+
+// validateSharedTolerations rejects provider scoped keys in the tolerations shared with the infrastructure-manager,
+// which applies the tolerations by region only and would not add them to the shoots.
+func validateSharedTolerations(tolerations config.TolerationsConfig) error {
+	for key := range tolerations {
+		if seeker.IsProviderTolerationsKey(key) {
+			return fmt.Errorf("%w: tolerations key %q scoped by provider type belongs to the syncer section", ErrInvalidValue, key)
+		}
+	}
+	return nil
+}
+
+// optionalStageNames are the names of the stages registered only when enabled by the program arguments.
+var optionalStageNames = []string{seeker.StageCloudProfile, seeker.StageStabilize, seeker.StageCatalog, seeker.StageNetworks}
+
+// optionalStages returns the optional stages enabled by the program arguments.
+func (c *Config) optionalStages() (out seeker.PipelineConfig) {
+	if c.Seed.CloudProfileCheck != CloudProfileCheckDisabled {
+		out.Filters = append(out.Filters, seeker.StageCloudProfile)
+	}
+	if c.Stabilization.enabled() {
+		out.Filters = append(out.Filters, seeker.StageStabilize)
+	}
+	if c.catalogEnabled() {
+		out.Sinks = append(out.Sinks, seeker.StageCatalog)
+	}
+	if c.networksEnabled() {
+		out.Sinks = append(out.Sinks, seeker.StageNetworks)
+	}
+	if c.Stabilization.enabled() {
+		out.Sinks = append(out.Sinks, seeker.StageStabilize)
+	}
+	return out
+}
+
+// stageNames are the names of all stages newRegistry registers, the optional ones when enabled.
+var stageNames = seeker.DefaultPipelineConfig(seeker.PipelineConfig{
+	Filters: []string{seeker.StageCloudProfile, seeker.StageStabilize},
+	Sinks:   []string{seeker.StageCatalog, seeker.StageNetworks, seeker.StageStabilize},
+})
+
+// validatePipeline checks that an explicit pipeline lists only known stages, the enabled optional stages, and no disabled ones,
+// which are not registered and would fail the build, and that the stabilize stages are the last ones of their kind.
+func (c syncerConfig) validatePipeline(optional seeker.PipelineConfig) error {
+	if c.Pipeline == nil {
+		return nil
+	}
+
+	return errors.Join(
+		validateStages("source", stageNames.Sources, optional.Sources, c.Pipeline.Sources),
+		validateStages("filter", stageNames.Filters, optional.Filters, c.Pipeline.Filters),
+		validateStages("transformer", stageNames.Transformers, optional.Transformers, c.Pipeline.Transformers),
+		validateStages("sink", stageNames.Sinks, optional.Sinks, c.Pipeline.Sinks),
+	)
+}
+
+func validateStages(kind string, known, enabled, listed []string) error {
+	for _, name := range listed {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("%w: unknown %s %q", seeker.ErrInvalidPipeline, kind, name)
+		}
+	}
+	for _, name := range enabled {
+		if !slices.Contains(listed, name) {
+			return fmt.Errorf("%w: %s %q is enabled by the program arguments but not listed", seeker.ErrInvalidPipeline, kind, name)
+		}
+	}
+	for _, name := range listed {
+		if slices.Contains(optionalStageNames, name) && !slices.Contains(enabled, name) {
+			return fmt.Errorf("%w: %s %q is listed but not enabled by the program arguments", seeker.ErrInvalidPipeline, kind, name)
+		}
+	}
+	// the stabilize filter has to see the final selection, and its sink must not persist the states before the result is published
+	if index := slices.Index(listed, seeker.StageStabilize); index >= 0 && index != len(listed)-1 {
+		return fmt.Errorf("%w: %s %q must be the last one", seeker.ErrInvalidPipeline, kind, seeker.StageStabilize)
+	}
+	return nil
+}
+
+// pipeline returns the configured pipeline or the default one.
+func (c converterConfig) pipeline(optional seeker.PipelineConfig) seeker.PipelineConfig {
+	if c.Syncer.Pipeline == nil {
+		return seeker.DefaultPipelineConfig(optional)
+	}
+	return *c.Syncer.Pipeline
+}
+
+// seedOpts combines the seed eligibility settings of the program arguments and the converter config.
+func seedOpts(cfg Config, converterCfg converterConfig) seeker.SeedOpts {
+	opts := seeker.SeedOpts{
+		Tolerations:         converterCfg.ConverterConfig.Tolerations,
+		ProviderTolerations: converterCfg.Syncer.Tolerations,
+		RequiredConditions:  converterCfg.Syncer.RequiredConditions,
+		LastOperation:       converterCfg.Syncer.LastOperation,
+		MaxConditionAge:     mustParseDuration(cfg.Seed.MaxConditionAge),
+		GenerationLagGrace:  mustParseDuration(cfg.Seed.GenerationLagGrace),
+		Rules:               converterCfg.rules,
+		Versions:            converterCfg.Syncer.Versions,
+		LabelKeys:           converterCfg.Syncer.LabelKeys,
+		Redundancy:          converterCfg.Syncer.Redundancy,
+		Mapping:             converterCfg.Syncer.Mapping,
+	}
+	switch cfg.Seed.ManagedSeeds {
+	case ManagedSeedsOnly:
+		opts.ManagedSeeds = seeker.ManagedSeedsOnly
+	case ManagedSeedsExclude:
+		opts.ManagedSeeds = seeker.ManagedSeedsExclude
+	}
+	if cfg.Seed.MaxGenerationLag >= 0 {
+		lag := int64(cfg.Seed.MaxGenerationLag)
+		opts.MaxGenerationLag = &lag
+	}
+	return opts
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newGardenerClient(cfg Config, get seeker.Get) (k8sclient.Client, error) {
+	opts, err := gardenerClientOptions(cfg, get)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(withClientLimits(opts, cfg.Gardener.Client, clientNameGardener), clientNameGardener)
+}
+
+func gardenerClientOptions(cfg Config, get seeker.Get) (client.Options, error) {
+	opts := client.Options{
+		AdditionalAddToSchema: []func(*runtime.Scheme) error{
+			v1beta1.AddToScheme,
+			seedmanagementv1alpha1.AddToScheme,
+		},
+	}
+
+	switch cfg.Gardener.AuthMethod {
+	case AuthMethodToken:
+		opts.Token = &client.TokenOptions{
+			Host:   cfg.Gardener.Host,
+			Path:   cfg.Gardener.TokenPath,
+			CAPath: cfg.Gardener.CAPath,
+		}
+	case AuthMethodSecret:
+		kubeconfig, err := readKubeconfigSecret(cfg, get)()
+		if err != nil {
+			return client.Options{}, err
+		}
+		opts.Kubeconfig = kubeconfig
+	default:
+		opts.KubeconfigPath = cfg.Gardener.KubeconfigPath
+	}
+
+	return opts, nil
+}
+
+// gardenerCredentials returns a reader of the credentials the Gardener client is built from, used to detect their rotation.
+// A rotated token file is re-read by client-go itself, so the token auth method never requires rebuilding the client.
+func gardenerCredentials(cfg Config, get seeker.Get) func() ([]byte, error) {
+	switch cfg.Gardener.AuthMethod {
+	case AuthMethodToken:
+		return func() ([]byte, error) { return nil, nil }
+	case AuthMethodSecret:
+		return readKubeconfigSecret(cfg, get)
+	default:
+		return readFile(cfg.Gardener.KubeconfigPath)
+	}
+}
+
+func readKubeconfigSecret(cfg Config, get seeker.Get) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultKcpClientTimeout)
+		defer cancel()
+
+		key := cfg.kubeconfigSecretKey()
+		var secret corev1.Secret
+		if err := get(ctx, key, &secret); err != nil {
+			return nil, fmt.Errorf("unable to get gardener kubeconfig secret %s: %w", key, err)
+		}
+
+		kubeconfig, found := secret.Data[cfg.Gardener.KubeconfigSecretKey]
+		if !found || len(kubeconfig) == 0 {
+			return nil, fmt.Errorf("gardener kubeconfig secret %s has no data under key %s", key, cfg.Gardener.KubeconfigSecretKey)
+		}
+		return kubeconfig, nil
+	}
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	errGetSecretFailedTest = fmt.Errorf("get secret failed test")
+	testKubeconfig         = []byte("test-kubeconfig")
+)
+
+func buildGetSecret(data map[string][]byte) seeker.Get {
+	return func(_ context.Context, _ k8sclient.ObjectKey, obj k8sclient.Object, _ ...k8sclient.GetOption) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func buildGetSecretWithError(err error) seeker.Get {
+	return func(context.Context, k8sclient.ObjectKey, k8sclient.Object, ...k8sclient.GetOption) error {
+		return err
+	}
+}
+
+func TestGardenerClientOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		gardener    Gardener
+		get         seeker.Get
+		expected    client.Options
+		expectedErr error
+	}{
+		{
+			name: "kubeconfig",
+			gardener: Gardener{
+				AuthMethod:     AuthMethodKubeconfig,
+				KubeconfigPath: "/gardener/kubeconfig",
+			},
+			expected: client.Options{KubeconfigPath: "/gardener/kubeconfig"},
+		},
+		{
+			name: "token",
+			gardener: Gardener{
+				AuthMethod: AuthMethodToken,
+				Host:       "https://api.gardener",
+				TokenPath:  "/var/run/secrets/gardener/token",
+				CAPath:     "/var/run/secrets/gardener/ca.crt",
+			},
+			expected: client.Options{Token: &client.TokenOptions{
+				Host:   "https://api.gardener",
+				Path:   "/var/run/secrets/gardener/token",
+				CAPath: "/var/run/secrets/gardener/ca.crt",
+			}},
+		},
+		{
+			name: "secret",
+			gardener: Gardener{
+				AuthMethod:          AuthMethodSecret,
+				KubeconfigSecretKey: "kubeconfig",
+			},
+			get:      buildGetSecret(map[string][]byte{"kubeconfig": testKubeconfig}),
+			expected: client.Options{Kubeconfig: testKubeconfig},
+		},
+		{
+			name: "secret without key",
+			gardener: Gardener{
+				AuthMethod:          AuthMethodSecret,
+				KubeconfigSecretKey: "kubeconfig",
+			},
+			get:         buildGetSecret(map[string][]byte{"config": testKubeconfig}),
+			expectedErr: fmt.Errorf("gardener kubeconfig secret / has no data under key kubeconfig"),
+		},
+		{
+			name: "secret get error",
+			gardener: Gardener{
+				AuthMethod: AuthMethodSecret,
+			},
+			get:         buildGetSecretWithError(errGetSecretFailedTest),
+			expectedErr: fmt.Errorf("unable to get gardener kubeconfig secret /: %w", errGetSecretFailedTest),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := gardenerClientOptions(Config{Gardener: testCase.gardener}, testCase.get)
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.EqualError(t, err, testCase.expectedErr.Error())
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Len(t, actual.AdditionalAddToSchema, 2)
+			actual.AdditionalAddToSchema = nil
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
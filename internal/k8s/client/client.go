@@ -7,15 +7,33 @@ import (
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 type Options struct {
-	KubeconfigPath        string
+	KubeconfigPath string
+	// Kubeconfig is the kubeconfig content, e.g. read from a Secret; it takes precedence over KubeconfigPath.
+	Kubeconfig []byte
+	// Token authenticates with a token file, e.g. a projected service account token; it takes precedence over the kubeconfig.
+	Token *TokenOptions
+	// QPS, Burst and Timeout override the client defaults when set.
+	QPS                   float32
+	Burst                 int
+	Timeout               time.Duration
+	UserAgent             string
 	AdditionalAddToSchema []func(*runtime.Scheme) error
 }
 
+// TokenOptions configures authentication with a bearer token file.
+// The file is re-read periodically by client-go, so a rotated token is picked up without rebuilding the client.
+type TokenOptions struct {
+	Host   string
+	Path   string
+	CAPath string
+}
+
 func New(opt Options, name string) (k8sClient client.Client, err error) {
 	defer seeker.LogWithDuration(time.Now(), "client created", "name", name)
 
@@ -26,14 +44,7 @@ func New(opt Options, name string) (k8sClient client.Client, err error) {
 		}
 	}
 
-	getRestConfig := config.GetConfig
-	if opt.KubeconfigPath != "" {
-		getRestConfig = func() (*rest.Config, error) {
-			return gardener.NewRestConfigFromFile(opt.KubeconfigPath)
-		}
-	}
-
-	restConfig, err := getRestConfig()
+	restConfig, err := NewRestConfig(opt)
 	if err != nil {
 		return nil, err
 	}
@@ -47,3 +58,48 @@ func New(opt Options, name string) (k8sClient client.Client, err error) {
 
 	return gardenerClient, nil
 }
+
+// NewRestConfig builds the rest config the client is created from, e.g. for client-go based tooling.
+func NewRestConfig(opt Options) (*rest.Config, error) {
+	restConfig, err := opt.restConfig()
+	if err != nil {
+		return nil, err
+	}
+	opt.applyLimits(restConfig)
+	return restConfig, nil
+}
+
+func (opt Options) applyLimits(restConfig *rest.Config) {
+	if opt.QPS > 0 {
+		restConfig.QPS = opt.QPS
+	}
+	if opt.Burst > 0 {
+		restConfig.Burst = opt.Burst
+	}
+	if opt.Timeout > 0 {
+		restConfig.Timeout = opt.Timeout
+	}
+	if opt.UserAgent != "" {
+		restConfig.UserAgent = opt.UserAgent
+	}
+}
+
+func (opt Options) restConfig() (*rest.Config, error) {
+	switch {
+	case opt.Token != nil:
+		return &rest.Config{
+			Host:            opt.Token.Host,
+			BearerTokenFile: opt.Token.Path,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAFile: opt.Token.CAPath,
+			},
+		}, nil
+	case len(opt.Kubeconfig) > 0:
+		return clientcmd.RESTConfigFromKubeConfig(opt.Kubeconfig)
+	case opt.KubeconfigPath != "":
+		// client certificates and tokens referenced by file path in the kubeconfig are reloaded by client-go on rotation
+		return gardener.NewRestConfigFromFile(opt.KubeconfigPath)
+	default:
+		return config.GetConfig()
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestRestConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     Options
+		expected *rest.Config
+	}{
+		{
+			name: "token with limits",
+			opts: Options{
+				Token: &TokenOptions{
+					Host:   "https://api.gardener",
+					Path:   "/var/run/secrets/gardener/token",
+					CAPath: "/var/run/secrets/gardener/ca.crt",
+				},
+				QPS:       50,
+				Burst:     100,
+				Timeout:   time.Minute,
+				UserAgent: "gardener-syncer/dev (gardener)",
+			},
+			expected: &rest.Config{
+				Host:            "https://api.gardener",
+				BearerTokenFile: "/var/run/secrets/gardener/token",
+				TLSClientConfig: rest.TLSClientConfig{
+					CAFile: "/var/run/secrets/gardener/ca.crt",
+				},
+				QPS:       50,
+				Burst:     100,
+				Timeout:   time.Minute,
+				UserAgent: "gardener-syncer/dev (gardener)",
+			},
+		},
+		{
+			name: "token without limits",
+			opts: Options{
+				Token: &TokenOptions{
+					Host: "https://api.gardener",
+					Path: "/var/run/secrets/gardener/token",
+				},
+			},
+			expected: &rest.Config{
+				Host:            "https://api.gardener",
+				BearerTokenFile: "/var/run/secrets/gardener/token",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := testCase.opts.restConfig()
+			require.NoError(t, err)
+			testCase.opts.applyLimits(actual)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	log "log/slog"
+	"os"
+
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var errLeadershipLost = fmt.Errorf("leadership lost")
+
+// runWithLeaderElection runs the given function only while holding the lease on the KCP cluster,
+// so replicas of a long-running deployment do not race on the seed map.
+func runWithLeaderElection(ctx context.Context, cfg LeaderElection, kcpOpts client.Options, run func(context.Context) error) error {
+	restConfig, err := client.NewRestConfig(kcpOpts)
+	if err != nil {
+		return err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	identity := fmt.Sprintf("%s_%s", hostname, uuid.NewUUID())
+
+	lock, err := resourcelock.NewFromKubeconfig(
+		resourcelock.LeasesResourceLock,
+		cfg.Namespace,
+		cfg.Name,
+		resourcelock.ResourceLockConfig{Identity: identity},
+		restConfig,
+		mustParseDuration(cfg.RenewDeadline),
+	)
+	if err != nil {
+		return err
+	}
+
+	return leaderElect(ctx, lock, cfg, run)
+}
+
+// leaderElect blocks until the context is done or the leadership is lost.
+// The lease is released only after the function returns, so no synchronisation outlives the leadership.
+func leaderElect(ctx context.Context, lock resourcelock.Interface, cfg LeaderElection, run func(context.Context) error) error {
+	identity := lock.Identity()
+	leading := make(chan context.Context, 1)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		Name:            cfg.Name,
+		LeaseDuration:   mustParseDuration(cfg.LeaseDuration),
+		RenewDeadline:   mustParseDuration(cfg.RenewDeadline),
+		RetryPeriod:     mustParseDuration(cfg.RetryPeriod),
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				log.Info("leadership acquired", "lease", lock.Describe(), "identity", identity)
+				leading <- leaderCtx
+			},
+			OnStoppedLeading: func() {
+				log.Info("leadership stopped", "lease", lock.Describe(), "identity", identity)
+			},
+			OnNewLeader: func(leader string) {
+				log.Info("leader observed", "lease", lock.Describe(), "leader", leader, "self", leader == identity)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the elector is not stopped by the signal directly, to keep the lease until the function returns
+	electorCtx, cancelElector := context.WithCancel(context.Background())
+	defer cancelElector()
+
+	electorDone := make(chan struct{})
+	go func() {
+		defer close(electorDone)
+		elector.Run(electorCtx)
+	}()
+
+	select {
+	case <-ctx.Done():
+		cancelElector()
+		<-electorDone
+		return nil
+
+	case leaderCtx := <-leading:
+		runCtx, cancelRun := context.WithCancel(leaderCtx)
+		defer cancelRun()
+		stop := context.AfterFunc(ctx, cancelRun)
+		defer stop()
+
+		err := run(runCtx)
+		lost := leaderCtx.Err() != nil
+
+		cancelElector()
+		<-electorDone
+
+		if err != nil {
+			return err
+		}
+		if lost {
+			return errLeadershipLost
+		}
+		return nil
+	}
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var testLeaderElection = LeaderElection{
+	Enabled:       true,
+	Namespace:     "test-namespace",
+	Name:          "test-lease",
+	LeaseDuration: "2s",
+	RenewDeadline: "1s",
+	RetryPeriod:   "100ms",
+}
+
+func TestLeaderElect(t *testing.T) {
+	// GIVEN
+	clientset := fake.NewClientset()
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		testLeaderElection.Namespace,
+		testLeaderElection.Name,
+		clientset.CoreV1(),
+		clientset.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: "test-identity"},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	running := make(chan struct{})
+	run := func(ctx context.Context) error {
+		lease, err := clientset.CoordinationV1().Leases(testLeaderElection.Namespace).Get(ctx, testLeaderElection.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "test-identity", *lease.Spec.HolderIdentity)
+
+		close(running)
+		<-ctx.Done()
+		return nil
+	}
+
+	// WHEN
+	done := make(chan error)
+	go func() {
+		done <- leaderElect(ctx, lock, testLeaderElection, run)
+	}()
+
+	// THEN
+	select {
+	case <-running:
+	case <-time.After(5 * time.Second):
+		t.Fatal("leadership not acquired")
+	}
+
+	// WHEN
+	cancel()
+
+	// THEN
+	require.NoError(t, <-done)
+	lease, err := clientset.CoordinationV1().Leases(testLeaderElection.Namespace).Get(context.Background(), testLeaderElection.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Empty(t, lease.Spec.HolderIdentity, "lease not released")
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"sigs.k8s.io/yaml"
+)
+
+// loadShootPlacement decodes the shoot placement input of the simulate command, accepting both JSON and YAML.
+func loadShootPlacement(path string) (shoot seeker.ShootPlacement, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return shoot, fmt.Errorf("unable to open shoot placement file %s: %w", path, err)
+	}
+
+	if err = yaml.UnmarshalStrict(data, &shoot); err != nil {
+		return shoot, fmt.Errorf("unable to decode shoot placement file %s: %w", path, err)
+	}
+
+	if shoot.Provider == "" || shoot.Region == "" {
+		return shoot, fmt.Errorf("%w: shoot placement file %s requires provider and region", ErrInvalidValue, path)
+	}
+	return shoot, nil
+}
+
+// simulate writes the seeds the shoot could be scheduled on, and why the other seeds are excluded, in YAML.
+// The seeds are not stabilized and the result is not stored.
+func simulate(ctx context.Context, cfg Config, source seeker.Source, opts seeker.SeedOpts, out io.Writer) error {
+	defer seeker.LogWithDuration(time.Now(), "placement simulation complete")
+
+	shoot, err := loadShootPlacement(cfg.SimulateShootPath)
+	if err != nil {
+		return err
+	}
+
+	seeds, err := source(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := seeker.SimulatePlacement(seeds, shoot, seeker.SimulationOpts{
+		SeedOpts:   opts,
+		HAMinZones: cfg.Seed.HAMinZones,
+	})
+	data, err := yaml.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(data)
+	return err
+}
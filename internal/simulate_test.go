@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+const shootPlacementPath = "config/test/shoot_placement.yaml"
+const shootPlacementInvalidPath = "config/test/shoot_placement_invalid.yaml"
+
+func TestSimulate(t *testing.T) {
+	seeds, err := loadSeeds(seedsFilePath)
+	require.NoError(t, err)
+	source := func(context.Context) ([]v1beta1.Seed, error) { return seeds.Items, nil }
+
+	t.Run("candidates and excluded seeds", func(t *testing.T) {
+		// GIVEN
+		var out bytes.Buffer
+
+		// WHEN
+		err := simulate(context.Background(), Config{SimulateShootPath: shootPlacementPath}, source, seeker.SeedOpts{}, &out)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, `candidates:
+- name: aws-ap1
+  region: ap-southeast-1
+excluded:
+- name: aws-ap2
+  reasons:
+  - seed is not visible for scheduling
+  region: ap-southeast-2
+- name: gcp-ha-sa1
+  reasons:
+  - provider type gcp does not match
+  - seed taints are not tolerated
+  region: region-central
+sameRegion: true
+`, out.String())
+	})
+
+	t.Run("invalid shoot placement", func(t *testing.T) {
+		err := simulate(context.Background(), Config{SimulateShootPath: shootPlacementInvalidPath}, source, seeker.SeedOpts{}, &bytes.Buffer{})
+		require.ErrorIs(t, err, ErrInvalidValue)
+	})
+}
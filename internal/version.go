@@ -0,0 +1,15 @@
+package cli
+
+import (
+	"fmt"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+)
+
+// Version is set at build time with -ldflags "-X github.com/kyma-project/gardener-syncer/internal.Version=<version>".
+var Version = "dev"
+
+// userAgent identifies the requests of the named client, so cluster operators can recognise and throttle the syncer traffic.
+func userAgent(name string) string {
+	return fmt.Sprintf("%s/%s (%s)", seeker.FieldManagerName, Version, name)
+}
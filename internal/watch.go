@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	log "log/slog"
+	"os"
+	"reflect"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchedContent detects content changes by comparing checksums of subsequent reads.
+// Polling the content works with ConfigMap and Secret volumes, which are updated by swapping symlinks.
+type watchedContent struct {
+	read   func() ([]byte, error)
+	digest [sha256.Size]byte
+}
+
+func newWatchedContent(read func() ([]byte, error)) (*watchedContent, error) {
+	data, err := read()
+	if err != nil {
+		return nil, err
+	}
+	return &watchedContent{read: read, digest: sha256.Sum256(data)}, nil
+}
+
+func newWatchedFile(path string) (*watchedContent, error) {
+	return newWatchedContent(readFile(path))
+}
+
+func readFile(path string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read watched file %s: %w", path, err)
+		}
+		return data, nil
+	}
+}
+
+// changed reports whether the content differs from the last committed one, and returns the digest of the current content.
+// The digest is not committed, so a change is reported again until the caller commits it after handling the change successfully.
+func (w *watchedContent) changed() ([sha256.Size]byte, bool, error) {
+	data, err := w.read()
+	if err != nil {
+		return w.digest, false, err
+	}
+
+	digest := sha256.Sum256(data)
+	return digest, digest != w.digest, nil
+}
+
+// commit marks the content with the given digest as handled.
+func (w *watchedContent) commit(digest [sha256.Size]byte) {
+	w.digest = digest
+}
+
+// reloadConverterConfig loads the converter config file and reports whether the tolerations or the syncer section,
+// from which the seed eligibility settings and the pipeline are built, differ from the current ones.
+// The decoded sections are compared, since the compiled rules differ on every load.
+func reloadConverterConfig(cfg Config, current converterConfig) (converterConfig, bool, error) {
+	converterCfg, err := loadConverterConfig(cfg.ConverterConfigFilepath, cfg.optionalStages())
+	if err != nil {
+		return current, false, err
+	}
+
+	changed := !reflect.DeepEqual(current.ConverterConfig.Tolerations, converterCfg.ConverterConfig.Tolerations) ||
+		!reflect.DeepEqual(current.Syncer, converterCfg.Syncer)
+	return converterCfg, changed, nil
+}
+
+// runWatch synchronises the seeds periodically until the context is done.
+// The converter config and the Gardener credentials are reloaded on change, and a change of the seed eligibility settings,
+// e.g. the tolerations, or of the pipeline triggers an immediate synchronisation.
+func runWatch(ctx context.Context, cfg Config, converterCfg converterConfig, kcpClient, gardenerClient k8sclient.Client) error {
+	get := kcpClient.Get
+	opts := seedOpts(cfg, converterCfg)
+	pipeline := converterCfg.pipeline(cfg.optionalStages())
+
+	// the pipeline is validated on start and on reload, other builds differ only in the gardener client
+	if _, err := buildSyncFn(cfg, pipeline, kcpClient, gardenerClient, opts); err != nil {
+		return err
+	}
+
+	converterConfigFile, err := newWatchedFile(cfg.ConverterConfigFilepath)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := newWatchedContent(gardenerCredentials(cfg, get))
+	if err != nil {
+		return err
+	}
+
+	syncTicker := time.NewTicker(mustParseDuration(cfg.Watch.SyncInterval))
+	defer syncTicker.Stop()
+
+	reloadTicker := time.NewTicker(mustParseDuration(cfg.Watch.ReloadInterval))
+	defer reloadTicker.Stop()
+
+	sync := func(ctx context.Context) {
+		sync, err := buildSyncFn(cfg, pipeline, kcpClient, gardenerClient, opts)
+		if err == nil {
+			err = sync(ctx)
+		}
+		if err != nil {
+			log.Error("synchronisation failed", "error", err)
+		}
+	}
+
+	sync(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("watch stopped")
+			return nil
+
+		case <-syncTicker.C:
+			sync(ctx)
+
+		case <-reloadTicker.C:
+			if digest, changed, err := credentials.changed(); err != nil {
+				log.Error("unable to check gardener credentials", "error", err)
+			} else if changed {
+				if reloaded, err := newGardenerClient(cfg, get); err != nil {
+					log.Error("unable to reload gardener client, keeping the previous one", "error", err)
+				} else {
+					gardenerClient = reloaded
+					credentials.commit(digest)
+					log.Info("gardener client reloaded", "authMethod", cfg.Gardener.AuthMethod)
+				}
+			}
+
+			digest, changed, err := converterConfigFile.changed()
+			if err != nil {
+				log.Error("unable to check converter config", "error", err)
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			reloaded, configChanged, err := reloadConverterConfig(cfg, converterCfg)
+			if err == nil && configChanged {
+				_, err = buildSyncFn(cfg, reloaded.pipeline(cfg.optionalStages()), kcpClient, gardenerClient, seedOpts(cfg, reloaded))
+			}
+			if err != nil {
+				log.Error("unable to reload converter config, keeping the previous settings", "error", err)
+				continue
+			}
+			converterConfigFile.commit(digest)
+
+			if configChanged {
+				converterCfg = reloaded
+				opts = seedOpts(cfg, converterCfg)
+				pipeline = converterCfg.pipeline(cfg.optionalStages())
+				log.Info("converter config reloaded, resynchronising", "path", cfg.ConverterConfigFilepath)
+				sync(seeker.WithResync(ctx))
+			}
+		}
+	}
+}
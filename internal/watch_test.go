@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchedFile(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte("initial"), 0o600))
+
+	file, err := newWatchedFile(path)
+	require.NoError(t, err)
+
+	// WHEN
+	_, changed, err := file.changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// WHEN
+	require.NoError(t, os.WriteFile(path, []byte("rotated"), 0o600))
+	digest, changed, err := file.changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// WHEN the change is not committed, e.g. because the reload failed
+	_, changed, err = file.changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// WHEN
+	file.commit(digest)
+	_, changed, err = file.changed()
+
+	// THEN
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// WHEN
+	require.NoError(t, os.Remove(path))
+	_, _, err = file.changed()
+
+	// THEN
+	require.Error(t, err)
+}
+
+func TestReloadConverterConfig(t *testing.T) {
+	configuredTolerations := config.TolerationsConfig{
+		"region-central": []v1beta1.Toleration{{Key: "configured-taint"}},
+	}
+	otherTolerations := config.TolerationsConfig{"region-central": {{Key: "other-taint"}}}
+
+	testCases := []struct {
+		name             string
+		path             string
+		current          converterConfig
+		expected         seeker.SeedOpts
+		expectedPipeline seeker.PipelineConfig
+		expectedChanged  bool
+		expectedErr      bool
+	}{
+		{
+			name:             "unchanged",
+			path:             converterConfigMinimalPath,
+			current:          converterConfigWithTolerations(configuredTolerations),
+			expected:         seeker.SeedOpts{Tolerations: configuredTolerations},
+			expectedPipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+		},
+		{
+			name:             "tolerations changed",
+			path:             converterConfigMinimalPath,
+			current:          converterConfigWithTolerations(otherTolerations),
+			expected:         seeker.SeedOpts{Tolerations: configuredTolerations},
+			expectedPipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			expectedChanged:  true,
+		},
+		{
+			name:    "required conditions changed",
+			path:    converterConfigSyncerPath,
+			current: converterConfigWithTolerations(configuredTolerations),
+			expected: seeker.SeedOpts{
+				Tolerations:         configuredTolerations,
+				ProviderTolerations: config.TolerationsConfig{"aws/*": {{Key: "aws-taint"}}},
+				RequiredConditions: []seeker.RequiredCondition{
+					{Type: "GardenletReady"},
+					{Type: "BackupBucketsReady", OnlyWithBackup: true},
+					{Type: "ExtensionsReady", AcceptProgressing: true},
+				},
+				LastOperation: seeker.LastOperationOpts{
+					AcceptedStates: []v1beta1.LastOperationState{"Succeeded", "Processing", "Error"},
+				},
+				Versions:  seeker.VersionOpts{Gardener: ">= 1.110", Kubernetes: ">= 1.30, < 1.34"},
+				LabelKeys: []string{"environment"},
+			},
+			expectedPipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			expectedChanged:  true,
+		},
+		{
+			name: "pipeline changed",
+			path: converterConfigPipelinePath,
+			current: converterConfig{
+				Config: config.Config{ConverterConfig: config.ConverterConfig{Tolerations: config.TolerationsConfig{}}},
+				Syncer: syncerConfig{Pipeline: &seeker.PipelineConfig{
+					Sources:      []string{seeker.StageGardener},
+					Transformers: []string{seeker.StageGroupRegions},
+					Sinks:        []string{seeker.StageConfigMap},
+				}},
+			},
+			expected:         seeker.SeedOpts{Tolerations: config.TolerationsConfig{}},
+			expectedPipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			expectedChanged:  true,
+		},
+		{
+			name:             "invalid config keeps current settings",
+			path:             converterConfigUnknownSyncerFieldPath,
+			current:          converterConfigWithTolerations(otherTolerations),
+			expected:         seeker.SeedOpts{Tolerations: otherTolerations},
+			expectedPipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			expectedErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			cfg := Config{
+				ConverterConfigFilepath: testCase.path,
+				Seed:                    Seed{MaxConditionAge: "0s", MaxGenerationLag: FlagDefaultSeedMaxGenerationLag, GenerationLagGrace: "0s", CloudProfileCheck: CloudProfileCheckDisabled},
+				Stabilization:           Stabilization{RemovalDelay: "0s", AdditionDelay: "0s"},
+			}
+
+			// WHEN
+			actual, changed, err := reloadConverterConfig(cfg, testCase.current)
+
+			// THEN
+			if testCase.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.expectedChanged, changed)
+			require.Equal(t, testCase.expected, seedOpts(cfg, actual))
+			require.Equal(t, testCase.expectedPipeline, actual.pipeline(cfg.optionalStages()))
+		})
+	}
+}
+
+func TestReloadConverterConfigWithRules(t *testing.T) {
+	// GIVEN
+	cfg := Config{
+		ConverterConfigFilepath: converterConfigRulesPath,
+		Seed:                    Seed{MaxConditionAge: "0s", MaxGenerationLag: FlagDefaultSeedMaxGenerationLag, GenerationLagGrace: "0s", CloudProfileCheck: CloudProfileCheckDisabled},
+		Stabilization:           Stabilization{RemovalDelay: "0s", AdditionDelay: "0s"},
+	}
+	current, err := loadConverterConfig(cfg.ConverterConfigFilepath, cfg.optionalStages())
+	require.NoError(t, err)
+
+	// WHEN
+	actual, changed, err := reloadConverterConfig(cfg, current)
+
+	// THEN
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Len(t, actual.rules, 1)
+}
+
+func converterConfigWithTolerations(tolerations config.TolerationsConfig) converterConfig {
+	return converterConfig{Config: config.Config{ConverterConfig: config.ConverterConfig{Tolerations: tolerations}}}
+}
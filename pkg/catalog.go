@@ -0,0 +1,135 @@
+package seeker
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type CatalogOpts struct {
+	Timeout time.Duration
+	Key     client.ObjectKey
+	// Mapping is applied to the providers and regions, like to the seed regions.
+	Mapping MappingOpts
+	List
+	Patch
+}
+
+// BuildRegionCatalog lists the regions of the cloud profiles per provider, with the number of the selected seeds in each.
+// Regions of several cloud profiles of the same provider are merged, and so are the regions mapped to the same name.
+// A region has a seed only if it is published in the providers, which may leave out regions with selected seeds, e.g. for redundancy.
+func BuildRegionCatalog(profiles []gardener_types.CloudProfile, selected []gardener_types.Seed, providers types.Providers, mapping MappingOpts) types.RegionCatalog {
+	offered := map[string]map[string]*types.CatalogRegion{}
+	for _, profile := range profiles {
+		for _, region := range profile.Spec.Regions {
+			addCatalogRegion(offered, profile.Spec.Type, region.Name, region, 0)
+		}
+	}
+
+	counts := CountSeeds(selected)
+	mapped := map[string]map[string]*types.CatalogRegion{}
+	for _, provider := range slices.Sorted(maps.Keys(offered)) {
+		name, providerMapping, found := mapping.provider(provider)
+		if !found {
+			continue
+		}
+
+		for _, regionName := range slices.Sorted(maps.Keys(offered[provider])) {
+			region := offered[provider][regionName]
+			addCatalogRegion(mapped, name, providerMapping.region(regionName), gardener_types.Region{
+				Zones:  zones(region.Zones),
+				Labels: region.Labels,
+			}, counts[provider][regionName])
+		}
+	}
+
+	out := types.RegionCatalog{}
+	for provider, regions := range mapped {
+		for _, regionName := range slices.Sorted(maps.Keys(regions)) {
+			region := *regions[regionName]
+			region.HasSeed = slices.Contains(providers[provider].SeedRegions, regionName)
+			out[provider] = append(out[provider], region)
+		}
+	}
+	return out
+}
+
+func addCatalogRegion(catalog map[string]map[string]*types.CatalogRegion, provider, name string, region gardener_types.Region, seedCount int) {
+	if catalog[provider] == nil {
+		catalog[provider] = map[string]*types.CatalogRegion{}
+	}
+
+	entry, found := catalog[provider][name]
+	if !found {
+		entry = &types.CatalogRegion{Name: name}
+		catalog[provider][name] = entry
+	}
+
+	entry.SeedCount += seedCount
+	for _, zone := range region.Zones {
+		if !slices.Contains(entry.Zones, zone.Name) {
+			entry.Zones = append(entry.Zones, zone.Name)
+		}
+	}
+	slices.Sort(entry.Zones)
+	if len(region.Labels) > 0 {
+		if entry.Labels == nil {
+			entry.Labels = map[string]string{}
+		}
+		maps.Copy(entry.Labels, region.Labels)
+	}
+}
+
+func zones(names []string) (out []gardener_types.AvailabilityZone) {
+	for _, name := range names {
+		out = append(out, gardener_types.AvailabilityZone{Name: name})
+	}
+	return out
+}
+
+func RegionCatalogToConfigMap(catalog types.RegionCatalog) (map[string]string, error) {
+	result := map[string]string{}
+	for provider, regions := range catalog {
+		data, err := yaml.Marshal(regions)
+		if err != nil {
+			return nil, err
+		}
+		result[provider] = strings.TrimRight(string(data), "\n")
+	}
+	return result, nil
+}
+
+// CatalogSink stores the region catalog built from the cloud profiles, the selected seeds and the published providers in a config map.
+func CatalogSink(opts CatalogOpts) Sink {
+	return func(ctx context.Context, selected []gardener_types.Seed, providers types.Providers) error {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+		defer LogWithDuration(time.Now(), "storing region catalog complete", "key", opts.Key)
+
+		profiles, err := listCloudProfilesOnce(ctx, opts.List)
+		if err != nil {
+			return err
+		}
+
+		data, err := RegionCatalogToConfigMap(BuildRegionCatalog(profiles.Items, selected, providers, opts.Mapping))
+		if err != nil {
+			return err
+		}
+
+		cm := corev1.ConfigMap{Data: data}
+		cm.Name = opts.Key.Name
+		cm.Namespace = opts.Key.Namespace
+		cm.TypeMeta.Kind = "ConfigMap"
+		cm.TypeMeta.APIVersion = "v1"
+
+		return applyConfigMap(ctx, opts.Patch, &cm)
+	}
+}
@@ -0,0 +1,141 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBuildRegionCatalog(t *testing.T) {
+	profiles := []gardener_types.CloudProfile{
+		{
+			Spec: gardener_types.CloudProfileSpec{
+				Type: testProviderType1,
+				Regions: []gardener_types.Region{
+					{Name: testRegion1, Zones: []gardener_types.AvailabilityZone{{Name: "zone-b"}, {Name: "zone-a"}}, Labels: map[string]string{"tier": "standard"}},
+					{Name: testRegion2},
+				},
+			},
+		},
+		{
+			Spec: gardener_types.CloudProfileSpec{
+				Type:    testProviderType1,
+				Regions: []gardener_types.Region{{Name: testRegion1, Zones: []gardener_types.AvailabilityZone{{Name: "zone-c"}}}},
+			},
+		},
+		{
+			Spec: gardener_types.CloudProfileSpec{
+				Type:    testProviderType2,
+				Regions: []gardener_types.Region{{Name: testRegion3}},
+			},
+		},
+	}
+	seeds := []gardener_types.Seed{newSeed(), newSeed()}
+
+	testCases := []struct {
+		name      string
+		mapping   seeker.MappingOpts
+		providers types.Providers
+		expected  types.RegionCatalog
+	}{
+		{
+			name:      "without mapping",
+			providers: types.Providers{testProviderType1: {SeedRegions: []string{testRegion1}}},
+			expected: types.RegionCatalog{
+				testProviderType1: {
+					{Name: testRegion1, HasSeed: true, SeedCount: 2, Zones: []string{"zone-a", "zone-b", "zone-c"}, Labels: map[string]string{"tier": "standard"}},
+					{Name: testRegion2},
+				},
+				testProviderType2: {
+					{Name: testRegion3},
+				},
+			},
+		},
+		{
+			name: "with mapping",
+			mapping: seeker.MappingOpts{
+				Providers: map[string]seeker.ProviderMapping{
+					testProviderType1: {Name: "kyma-provider", Regions: map[string]string{testRegion2: testRegion1}},
+				},
+				DropUnknownProviders: true,
+			},
+			providers: types.Providers{"kyma-provider": {SeedRegions: []string{testRegion1}}},
+			expected: types.RegionCatalog{
+				"kyma-provider": {
+					{Name: testRegion1, HasSeed: true, SeedCount: 2, Zones: []string{"zone-a", "zone-b", "zone-c"}, Labels: map[string]string{"tier": "standard"}},
+				},
+			},
+		},
+		{
+			name:      "region not published",
+			providers: types.Providers{},
+			expected: types.RegionCatalog{
+				testProviderType1: {
+					{Name: testRegion1, SeedCount: 2, Zones: []string{"zone-a", "zone-b", "zone-c"}, Labels: map[string]string{"tier": "standard"}},
+					{Name: testRegion2},
+				},
+				testProviderType2: {
+					{Name: testRegion3},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.BuildRegionCatalog(profiles, seeds, testCase.providers, testCase.mapping)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestCatalogSink(t *testing.T) {
+	// GIVEN
+	sink := seeker.CatalogSink(seeker.CatalogOpts{
+		Key:   client.ObjectKey{Name: "test-catalog", Namespace: "test-namespace"},
+		List:  buildCloudProfileList(testCloudProfiles),
+		Patch: buildPatch("test-catalog", "test-namespace", stringMap{testProviderType2: "- hasSeed: false\n  name: test-region2\n  seedCount: 0"}),
+	})
+
+	// WHEN
+	err := sink(context.Background(), []gardener_types.Seed{newSeed()}, nil)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestCatalogSinkSharesCloudProfiles(t *testing.T) {
+	// GIVEN
+	calls := 0
+	list := func(ctx context.Context, ol client.ObjectList, opts ...client.ListOption) error {
+		calls++
+		return buildCloudProfileList(testCloudProfiles)(ctx, ol, opts...)
+	}
+	filter := seeker.CloudProfileFilter(list, time.Minute, false)
+	sink := seeker.CatalogSink(seeker.CatalogOpts{
+		Timeout: time.Minute,
+		Key:     client.ObjectKey{Name: "test-catalog", Namespace: "test-namespace"},
+		List:    list,
+		Patch:   buildPatch("test-catalog", "test-namespace", stringMap{testProviderType2: "- hasSeed: false\n  name: test-region2\n  seedCount: 0"}),
+	})
+	seeds := []gardener_types.Seed{newSeed()}
+	ctx := seeker.WithSyncScope(context.Background())
+
+	// WHEN
+	selected, err := filter(ctx, seeds, seeds)
+	require.NoError(t, err)
+	err = sink(ctx, selected, nil)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+}
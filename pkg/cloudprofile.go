@@ -0,0 +1,105 @@
+package seeker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// CloudProfileRegions are the regions offered to shoots per provider type.
+type CloudProfileRegions map[string]map[string]struct{}
+
+func NewCloudProfileRegions(profiles []gardener_types.CloudProfile) CloudProfileRegions {
+	out := CloudProfileRegions{}
+	for _, profile := range profiles {
+		provider := profile.Spec.Type
+		if out[provider] == nil {
+			out[provider] = map[string]struct{}{}
+		}
+		for _, region := range profile.Spec.Regions {
+			out[provider][region.Name] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Offers reports whether any cloud profile of the provider type offers the region.
+func (r CloudProfileRegions) Offers(provider, region string) bool {
+	_, found := r[provider][region]
+	return found
+}
+
+func ListCloudProfiles(ctx context.Context, list List) (profiles gardener_types.CloudProfileList, err error) {
+	defer func() {
+		LogWithDuration(time.Now(), "gardener-cloudprofile list complete", "count", len(profiles.Items))
+	}()
+
+	if err = list(ctx, &profiles); err != nil {
+		return gardener_types.CloudProfileList{}, err
+	}
+
+	return profiles, nil
+}
+
+type cloudProfilesScopeKey struct{}
+
+// listCloudProfilesOnce lists the cloud profiles once per synchronisation, so the stages needing them share the result.
+func listCloudProfilesOnce(ctx context.Context, list List) (gardener_types.CloudProfileList, error) {
+	if profiles, found := scopeValue[gardener_types.CloudProfileList](ctx, cloudProfilesScopeKey{}); found {
+		return profiles, nil
+	}
+
+	profiles, err := ListCloudProfiles(ctx, list)
+	if err != nil {
+		return profiles, err
+	}
+	setScopeValue(ctx, cloudProfilesScopeKey{}, profiles)
+	return profiles, nil
+}
+
+// ValidateSeedRegions reports the seeds whose region is not offered by any cloud profile of their provider type.
+func ValidateSeedRegions(regions CloudProfileRegions, seeds []gardener_types.Seed) (warnings []string) {
+	for _, seed := range seeds {
+		if !regions.Offers(seed.Spec.Provider.Type, seed.Spec.Provider.Region) {
+			warnings = append(warnings, fmt.Sprintf("seed %q region %q is offered by no cloud profile of provider %q",
+				seed.Name, seed.Spec.Provider.Region, seed.Spec.Provider.Type))
+		}
+	}
+	return warnings
+}
+
+// CloudProfileFilter reports the selected seeds whose region is not offered to shoots by any cloud profile,
+// and removes them if remove is true.
+func CloudProfileFilter(list List, timeout time.Duration, remove bool) Filter {
+	return func(ctx context.Context, _, selected []gardener_types.Seed) ([]gardener_types.Seed, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		profiles, err := listCloudProfilesOnce(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+
+		regions := NewCloudProfileRegions(profiles.Items)
+		var out []gardener_types.Seed
+		for _, seed := range selected {
+			if regions.Offers(seed.Spec.Provider.Type, seed.Spec.Provider.Region) {
+				out = append(out, seed)
+				continue
+			}
+
+			slog.Warn("seed region offered by no cloud profile",
+				"name", seed.Name,
+				"provider", seed.Spec.Provider.Type,
+				"region", seed.Spec.Provider.Region,
+				"removed", remove)
+			if !remove {
+				out = append(out, seed)
+			}
+		}
+		return out, nil
+	}
+}
@@ -0,0 +1,96 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testCloudProfiles = []gardener_types.CloudProfile{
+	{
+		Spec: gardener_types.CloudProfileSpec{
+			Type:    testProviderType1,
+			Regions: []gardener_types.Region{{Name: testRegion1}},
+		},
+	},
+	{
+		Spec: gardener_types.CloudProfileSpec{
+			Type:    testProviderType2,
+			Regions: []gardener_types.Region{{Name: testRegion2}},
+		},
+	},
+}
+
+func TestCloudProfileFilter(t *testing.T) {
+	offered := newSeed(withName("offered"))
+	notOffered := newSeed(withName("not-offered"), withRegion(testRegion2))
+
+	testCases := []struct {
+		name        string
+		list        seeker.List
+		remove      bool
+		expected    []gardener_types.Seed
+		expectedErr error
+	}{
+		{
+			name:     "report",
+			list:     buildCloudProfileList(testCloudProfiles),
+			expected: []gardener_types.Seed{offered, notOffered},
+		},
+		{
+			name:     "filter",
+			list:     buildCloudProfileList(testCloudProfiles),
+			remove:   true,
+			expected: []gardener_types.Seed{offered},
+		},
+		{
+			name:        "list error",
+			list:        buildListWithError(errListFailedTest),
+			expectedErr: errListFailedTest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			filter := seeker.CloudProfileFilter(testCase.list, 0, testCase.remove)
+			seeds := []gardener_types.Seed{offered, notOffered}
+
+			// WHEN
+			actual, err := filter(context.Background(), seeds, seeds)
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestValidateSeedRegions(t *testing.T) {
+	// GIVEN
+	regions := seeker.NewCloudProfileRegions(testCloudProfiles)
+	seed := newSeed(withName("seed"), withRegion(testRegion2))
+
+	// WHEN
+	actual := seeker.ValidateSeedRegions(regions, []gardener_types.Seed{newSeed(), seed})
+
+	// THEN
+	require.Equal(t, []string{
+		`seed "seed" region "test-region2" is offered by no cloud profile of provider "test-provider-type1"`,
+	}, actual)
+}
+
+func buildCloudProfileList(items []gardener_types.CloudProfile) seeker.List {
+	return func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+		ol.(*gardener_types.CloudProfileList).Items = items
+		return nil
+	}
+}
@@ -1,7 +1,10 @@
 package seeker
 
 import (
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,22 +17,236 @@ import (
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 )
 
-func VerifySeedReadiness(seed *gardener_types.Seed) bool {
-	if seed.Status.LastOperation == nil {
+// RequiredCondition is a seed condition that has to be satisfied for the seed to be ready.
+type RequiredCondition struct {
+	Type gardener_types.ConditionType `json:"type"`
+	// AcceptProgressing accepts the Progressing status in addition to True.
+	AcceptProgressing bool `json:"acceptProgressing,omitempty"`
+	// AcceptUnknown accepts the Unknown status in addition to True.
+	AcceptUnknown bool `json:"acceptUnknown,omitempty"`
+	// OnlyWithBackup requires the condition only from seeds with a backup configured.
+	OnlyWithBackup bool `json:"onlyWithBackup,omitempty"`
+}
+
+var DefaultRequiredConditions = []RequiredCondition{
+	{Type: gardener_types.GardenletReady},
+	{Type: gardener_types.SeedBackupBucketsReady, OnlyWithBackup: true},
+}
+
+var DefaultAcceptedLastOperationStates = []gardener_types.LastOperationState{
+	gardener_types.LastOperationStateSucceeded,
+	gardener_types.LastOperationStateProcessing,
+	gardener_types.LastOperationStatePending,
+}
+
+var DefaultRejectedLastOperationTypes = []gardener_types.LastOperationType{
+	gardener_types.LastOperationTypeDelete,
+}
+
+// LastOperationOpts configures which last operations of a seed are acceptable.
+type LastOperationOpts struct {
+	// AcceptedStates defaults to DefaultAcceptedLastOperationStates.
+	AcceptedStates []gardener_types.LastOperationState `json:"acceptedStates,omitempty"`
+	// RejectedTypes defaults to DefaultRejectedLastOperationTypes.
+	RejectedTypes []gardener_types.LastOperationType `json:"rejectedTypes,omitempty"`
+}
+
+func (opts LastOperationOpts) accepts(op *gardener_types.LastOperation) bool {
+	acceptedStates := opts.AcceptedStates
+	if acceptedStates == nil {
+		acceptedStates = DefaultAcceptedLastOperationStates
+	}
+
+	rejectedTypes := opts.RejectedTypes
+	if rejectedTypes == nil {
+		rejectedTypes = DefaultRejectedLastOperationTypes
+	}
+
+	return slices.Contains(acceptedStates, op.State) && !slices.Contains(rejectedTypes, op.Type)
+}
+
+// SeedOpts configures the seed eligibility checks.
+type SeedOpts struct {
+	// Tolerations are keyed by region, the format shared with the infrastructure-manager.
+	Tolerations config.TolerationsConfig
+	// ProviderTolerations are keyed by TolerationsKey, they are known to the syncer only.
+	ProviderTolerations config.TolerationsConfig
+	// RequiredConditions is optional and defaults to DefaultRequiredConditions.
+	RequiredConditions []RequiredCondition
+	// LastOperation is optional and defaults to the accepted states and rejected types above.
+	LastOperation LastOperationOpts
+	// MaxConditionAge is optional, conditions not updated within it are treated as not ready.
+	MaxConditionAge time.Duration
+	// MaxGenerationLag is optional, seeds whose status lags more generations behind the spec for longer than GenerationLagGrace
+	// are treated as not ready.
+	MaxGenerationLag *int64
+	// GenerationLagGrace is the time the gardenlet has to reconcile a changed seed spec, see specUpdateTime.
+	GenerationLagGrace time.Duration
+	// Rules is optional, seeds not satisfying any of them are not usable.
+	Rules []CompiledSeedRule
+	// Versions is optional, seeds whose versions do not satisfy the constraints are not usable.
+	Versions VersionOpts
+	// ManagedSeeds is optional, it applies only to the seeds classified by the ManagedSeedSource.
+	ManagedSeeds ManagedSeedPolicy
+	// LabelKeys is optional, the values of the seed labels with these keys are published per region by the labels transformer.
+	LabelKeys []string
+	// Redundancy is optional and applied to the regions by the redundancy transformer.
+	Redundancy RedundancyOpts
+	// Mapping is optional and applied to the result by the mapping transformer.
+	Mapping MappingOpts
+	// Now is optional and defaults to time.Now.
+	Now func() time.Time
+}
+
+func (opts SeedOpts) now() time.Time {
+	if opts.Now == nil {
+		return time.Now()
+	}
+	return opts.Now()
+}
+
+func (opts SeedOpts) requiredConditions() []RequiredCondition {
+	if opts.RequiredConditions == nil {
+		return DefaultRequiredConditions
+	}
+	return opts.RequiredConditions
+}
+
+func VerifySeedReadiness(seed *gardener_types.Seed, opts SeedOpts) bool {
+	if seed.Status.LastOperation == nil || !opts.LastOperation.accepts(seed.Status.LastOperation) {
 		return false
 	}
 
-	if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardener_types.GardenletReady); cond == nil || cond.Status != gardener_types.ConditionTrue {
+	if generationLagging(seed, opts) {
+		return false
+	}
+
+	return len(unsatisfiedConditions(seed, opts)) == 0
+}
+
+// generationLagging reports whether the gardenlet has not reconciled the latest seed spec within the grace period,
+// in which case the conditions describe the previous spec.
+func generationLagging(seed *gardener_types.Seed, opts SeedOpts) bool {
+	if opts.MaxGenerationLag == nil || seed.Generation-seed.Status.ObservedGeneration <= *opts.MaxGenerationLag {
 		return false
 	}
 
-	if seed.Spec.Backup != nil {
-		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardener_types.SeedBackupBucketsReady); cond == nil || cond.Status != gardener_types.ConditionTrue {
+	since, found := specUpdateTime(seed)
+	return !found || opts.now().Sub(since) > opts.GenerationLagGrace
+}
+
+// specUpdateTime returns the time of the latest seed update by a field manager other than the status one,
+// which bumps the generation in case of a spec change. Without managed fields, the time of the last operation is used,
+// and without it, the lag has no grace period.
+func specUpdateTime(seed *gardener_types.Seed) (time.Time, bool) {
+	var latest time.Time
+	for _, fields := range seed.ManagedFields {
+		if fields.Subresource == "" && fields.Time != nil && fields.Time.After(latest) {
+			latest = fields.Time.Time
+		}
+	}
+	if !latest.IsZero() {
+		return latest, true
+	}
+
+	if op := seed.Status.LastOperation; op != nil && !op.LastUpdateTime.IsZero() {
+		return op.LastUpdateTime.Time, true
+	}
+	return time.Time{}, false
+}
+
+// unsatisfiedConditions returns the types of the required conditions the seed does not satisfy.
+func unsatisfiedConditions(seed *gardener_types.Seed, opts SeedOpts) (out []string) {
+	for _, required := range opts.requiredConditions() {
+		if required.OnlyWithBackup && seed.Spec.Backup == nil {
+			continue
+		}
+
+		cond := v1beta1helper.GetCondition(seed.Status.Conditions, required.Type)
+		if !required.satisfiedBy(cond, opts) {
+			out = append(out, string(required.Type))
+		}
+	}
+	return out
+}
+
+func (required RequiredCondition) satisfiedBy(cond *gardener_types.Condition, opts SeedOpts) bool {
+	if cond == nil {
+		return false
+	}
+
+	switch cond.Status {
+	case gardener_types.ConditionTrue:
+	case gardener_types.ConditionProgressing:
+		if !required.AcceptProgressing {
+			return false
+		}
+	case gardener_types.ConditionUnknown:
+		if !required.AcceptUnknown {
 			return false
 		}
+	default:
+		return false
 	}
 
-	return true
+	// a gardenlet that stopped working does not update the conditions anymore
+	return opts.MaxConditionAge <= 0 || conditionAge(cond, opts) <= opts.MaxConditionAge
+}
+
+func conditionAge(cond *gardener_types.Condition, opts SeedOpts) time.Duration {
+	return opts.now().Sub(cond.LastUpdateTime.Time)
+}
+
+// conditionAges returns the ages of the conditions of the given types, keyed by type, the missing ones are skipped.
+func conditionAges(seed *gardener_types.Seed, conditionTypes []string, opts SeedOpts) map[string]string {
+	out := map[string]string{}
+	for _, conditionType := range conditionTypes {
+		cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardener_types.ConditionType(conditionType))
+		if cond != nil {
+			out[conditionType] = conditionAge(cond, opts).Round(time.Second).String()
+		}
+	}
+	return out
+}
+
+// tolerations returns the region and provider scoped tolerations as one lookup for SeedTolerations,
+// their keys do not overlap since only the provider scoped ones contain the provider type.
+func (o SeedOpts) tolerations() config.TolerationsConfig {
+	if len(o.ProviderTolerations) == 0 {
+		return o.Tolerations
+	}
+
+	out := make(config.TolerationsConfig, len(o.Tolerations)+len(o.ProviderTolerations))
+	maps.Copy(out, o.Tolerations)
+	maps.Copy(out, o.ProviderTolerations)
+	return out
+}
+
+// IsProviderTolerationsKey reports whether the tolerations key is scoped by the provider type, see TolerationsKey.
+func IsProviderTolerationsKey(key string) bool {
+	return strings.Contains(key, "/")
+}
+
+// ProviderWideRegion is the region part of a tolerations key applying to all regions of a provider.
+const ProviderWideRegion = "*"
+
+// TolerationsKey is the tolerations key scoped by the provider type, `<provider>/<region>`.
+// The region ProviderWideRegion makes the tolerations apply to all regions of the provider.
+func TolerationsKey(provider, region string) string {
+	return provider + "/" + region
+}
+
+// SeedTolerations looks the tolerations of a seed up in order of precedence:
+// by provider type and region, by region only, which is the format of the infrastructure-manager, and by provider type only.
+// The provider scoped keys are configured in the syncer section, since the infrastructure-manager does not apply them.
+// The first key found is used, the tolerations of different keys are not merged.
+func SeedTolerations(tolerationConfig config.TolerationsConfig, provider, region string) ([]gardener_types.Toleration, bool) {
+	for _, key := range []string{TolerationsKey(provider, region), region, TolerationsKey(provider, ProviderWideRegion)} {
+		if tolerations, found := tolerationConfig[key]; found {
+			return tolerations, true
+		}
+	}
+	return nil, false
 }
 
 func VerifySeedTaints(seed *gardener_types.Seed, tolerationConfig config.TolerationsConfig) bool {
@@ -37,7 +254,7 @@ func VerifySeedTaints(seed *gardener_types.Seed, tolerationConfig config.Tolerat
 		return true
 	}
 
-	tolerations, seedRegionHasTolerations := tolerationConfig[seed.Spec.Provider.Region]
+	tolerations, seedRegionHasTolerations := SeedTolerations(tolerationConfig, seed.Spec.Provider.Type, seed.Spec.Provider.Region)
 
 	if !seedRegionHasTolerations {
 		return false // If seed has taints and there are no tolerations for the seed region, we cannot use the seed
@@ -69,44 +286,148 @@ func TaintMatched(taint gardener_types.SeedTaint, tolerations []gardener_types.T
 	return false
 }
 
-func SeedCanBeUsed(seed *gardener_types.Seed, tolerations config.TolerationsConfig) bool {
-	hasNoDeletionTimestamp := seed.DeletionTimestamp == nil
-	isReady := VerifySeedReadiness(seed)
-	isVisible := seed.Spec.Settings != nil &&
-		seed.Spec.Settings.Scheduling != nil &&
-		seed.Spec.Settings.Scheduling.Visible
+func SeedCanBeUsed(seed *gardener_types.Seed, opts SeedOpts) bool {
+	eval := evaluateSeed(seed, opts)
+	if eval.usable() {
+		slog.Debug("seed accepted", managedSeedLogArgs(seed, "name", seed.Name)...)
+		return true
+	}
+
+	conditions := unsatisfiedConditions(seed, opts)
+	args := []any{
+		"name", seed.Name,
+		"hasNoDeletionTimestamp", eval.hasNoDeletionTimestamp,
+		"isVisible", eval.isVisible,
+		"hasCorrectTaintsConfig", eval.hasCorrectTaintsConfig,
+		"isReady", eval.isReady,
+		"unsatisfiedConditions", conditions,
+		"failedRules", eval.failedRules,
+		"unsatisfiedVersions", eval.unsatisfiedVersions,
+		"gardenerVersion", gardenerVersion(seed),
+		"kubernetesVersion", kubernetesVersion(seed),
+		"isAllowedManagedSeed", eval.isAllowedManagedSeed,
+	}
+	args = managedSeedLogArgs(seed, args...)
+	if ages := conditionAges(seed, conditions, opts); len(ages) > 0 {
+		args = append(args, "unsatisfiedConditionAges", ages)
+	}
+	if op := seed.Status.LastOperation; op != nil {
+		args = append(args, "lastOperationType", op.Type, "lastOperationState", op.State)
+	}
+	if generationLagging(seed, opts) {
+		args = append(args, "generation", seed.Generation, "observedGeneration", seed.Status.ObservedGeneration)
+	}
+	slog.Info("seed rejected", args...)
+	return false
+}
+
+// seedEvaluation holds the results of the eligibility checks of a seed.
+type seedEvaluation struct {
+	hasNoDeletionTimestamp bool
+	isVisible              bool
+	isReady                bool
+	hasCorrectTaintsConfig bool
+	isAllowedManagedSeed   bool
+	failedRules            []string
+	unsatisfiedVersions    []string
+}
+
+func evaluateSeed(seed *gardener_types.Seed, opts SeedOpts) seedEvaluation {
+	return seedEvaluation{
+		hasNoDeletionTimestamp: seed.DeletionTimestamp == nil,
+		isVisible: seed.Spec.Settings != nil &&
+			seed.Spec.Settings.Scheduling != nil &&
+			seed.Spec.Settings.Scheduling.Visible,
+		isReady:                VerifySeedReadiness(seed, opts),
+		hasCorrectTaintsConfig: VerifySeedTaints(seed, opts.tolerations()),
+		isAllowedManagedSeed:   opts.ManagedSeeds.accepts(seed),
+		failedRules:            failedSeedRules(seed, opts),
+		unsatisfiedVersions:    unsatisfiedVersions(seed, opts.Versions),
+	}
+}
 
-	hasCorrectTaintsConfig := VerifySeedTaints(seed, tolerations)
+func (eval seedEvaluation) usable() bool {
+	return eval.hasNoDeletionTimestamp && eval.isVisible && eval.isReady && eval.hasCorrectTaintsConfig &&
+		eval.isAllowedManagedSeed && len(eval.failedRules) == 0 && len(eval.unsatisfiedVersions) == 0
+}
+
+// reasons describes the failed checks, empty if the seed is usable.
+func (eval seedEvaluation) reasons(seed *gardener_types.Seed, opts SeedOpts) (out []string) {
+	if !eval.hasNoDeletionTimestamp {
+		out = append(out, "seed is being deleted")
+	}
+	if !eval.isVisible {
+		out = append(out, "seed is not visible for scheduling")
+	}
+	if !eval.isReady {
+		out = append(out, readinessReasons(seed, opts)...)
+	}
+	if !eval.hasCorrectTaintsConfig {
+		out = append(out, "seed taints are not tolerated")
+	}
+	if !eval.isAllowedManagedSeed {
+		out = append(out, "seed is not allowed by the managed seed policy")
+	}
+	if len(eval.failedRules) > 0 {
+		out = append(out, "seed rules not satisfied: "+strings.Join(eval.failedRules, ","))
+	}
+	if len(eval.unsatisfiedVersions) > 0 {
+		out = append(out, "version constraints not satisfied: "+strings.Join(eval.unsatisfiedVersions, ","))
+	}
+	return out
+}
 
-	result := hasNoDeletionTimestamp && isVisible && isReady && hasCorrectTaintsConfig
-	if !result {
-		slog.Info("seed rejected",
-			"name", seed.Name,
-			"hasNoDeletionTimestamp", hasNoDeletionTimestamp,
-			"isVisible", isVisible,
-			"hasCorrectTaintsConfig", hasCorrectTaintsConfig,
-			"isReady", isReady)
+func readinessReasons(seed *gardener_types.Seed, opts SeedOpts) (out []string) {
+	if op := seed.Status.LastOperation; op == nil {
+		out = append(out, "seed has no last operation")
+	} else if !opts.LastOperation.accepts(op) {
+		out = append(out, fmt.Sprintf("last operation %s %s not accepted", op.Type, op.State))
 	}
-	return result
+	if generationLagging(seed, opts) {
+		out = append(out, fmt.Sprintf("observed generation %d lags behind generation %d", seed.Status.ObservedGeneration, seed.Generation))
+	}
+	if conditions := unsatisfiedConditions(seed, opts); len(conditions) > 0 {
+		out = append(out, "conditions not satisfied: "+strings.Join(conditions, ","))
+	}
+	return out
 }
 
-func ToProviderRegions(seeds []gardener_types.Seed, tolerations config.TolerationsConfig) (out types.Providers) {
-	defer LogWithDuration(time.Now(), "conversion complete")
+func ToProviderRegions(seeds []gardener_types.Seed, opts SeedOpts) (out types.Providers) {
+	out, _ = GroupRegionsTransformer(UsableSeeds(seeds, opts), types.Providers{})
+	return out
+}
 
-	out = types.Providers{}
+func UsableSeeds(seeds []gardener_types.Seed, opts SeedOpts) (out []gardener_types.Seed) {
 	for _, seed := range seeds {
-		if SeedCanBeUsed(&seed, tolerations) {
-			out.Add(
-				seed.Spec.Provider.Type,
-				seed.Spec.Provider.Region,
-			)
-			continue
+		if SeedCanBeUsed(&seed, opts) {
+			out = append(out, seed)
 		}
 	}
-
 	return out
 }
 
+// addSeedRegion adds the region of the seed, also to the regions of the access restrictions the seed supports,
+// and the IP families of the seed, if set, to the ones supported in the region.
+func addSeedRegion(providers types.Providers, seed gardener_types.Seed) {
+	provider, region := seed.Spec.Provider.Type, seed.Spec.Provider.Region
+	providers.Add(provider, region)
+	for _, restriction := range seed.Spec.AccessRestrictions {
+		providers.AddAccessRestriction(provider, restriction.Name, region)
+	}
+	if stack := IPFamilyStack(seed.Spec.Networks.IPFamilies); stack != "" {
+		providers.AddIPFamilies(provider, region, stack)
+	}
+}
+
+// IPFamilyStack joins the IP families in their order, the first one being the primary family.
+func IPFamilyStack(families []gardener_types.IPFamily) string {
+	names := make([]string, 0, len(families))
+	for _, family := range families {
+		names = append(names, string(family))
+	}
+	return strings.Join(names, ",")
+}
+
 func ToConfigMap(providerRegions types.Providers) (map[string]string, error) {
 	result := map[string]string{}
 	for k, v := range providerRegions {
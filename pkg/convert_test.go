@@ -1,7 +1,12 @@
 package seeker_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"slices"
 	"testing"
+	"time"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
@@ -9,6 +14,7 @@ import (
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -54,7 +60,7 @@ var (
 			},
 		},
 		Status: gardener_types.SeedStatus{
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 	testSeedGardenletReadyFalse = gardener_types.Seed{
@@ -72,7 +78,7 @@ var (
 					Status: gardener_types.ConditionFalse,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 	testSeedNoSeedBackupBucketsReady = gardener_types.Seed{
@@ -91,7 +97,7 @@ var (
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 	testSeedSeedBackupBucketsReadyFalse = gardener_types.Seed{
@@ -114,7 +120,7 @@ var (
 					Status: gardener_types.ConditionFalse,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 	testSeedWithTaints = gardener_types.Seed{
@@ -141,7 +147,7 @@ var (
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 
@@ -169,7 +175,7 @@ var (
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 
@@ -192,7 +198,7 @@ var (
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 	testSeedOKWithBackup = gardener_types.Seed{
@@ -219,7 +225,7 @@ var (
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
 		},
 	}
 )
@@ -281,6 +287,43 @@ func TestToProvideRegions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "access restrictions",
+			seeds: []gardener_types.Seed{
+				newSeed(withRegion(testRegion1), withAccessRestrictions("eu-access-only")),
+				newSeed(withRegion(testRegion1)),
+				newSeed(withRegion(testRegion2), withAccessRestrictions("eu-access-only", "other-restriction")),
+				newSeed(withRegion(testRegion3)),
+			},
+			expected: types.Providers{
+				testProviderType1: {
+					SeedRegions: []string{testRegion1, testRegion2, testRegion3},
+					AccessRestrictions: map[string][]string{
+						"eu-access-only":    {testRegion1, testRegion2},
+						"other-restriction": {testRegion2},
+					},
+				},
+			},
+		},
+		{
+			name: "ip families",
+			seeds: []gardener_types.Seed{
+				newSeed(withRegion(testRegion1), withIPFamilies(gardener_types.IPFamilyIPv4)),
+				newSeed(withRegion(testRegion1), withIPFamilies(gardener_types.IPFamilyIPv4, gardener_types.IPFamilyIPv6)),
+				newSeed(withRegion(testRegion2), withIPFamilies(gardener_types.IPFamilyIPv6)),
+				newSeed(withRegion(testRegion2), withIPFamilies(gardener_types.IPFamilyIPv6)),
+				newSeed(withRegion(testRegion3)),
+			},
+			expected: types.Providers{
+				testProviderType1: {
+					SeedRegions: []string{testRegion1, testRegion2, testRegion3},
+					IPFamilies: map[string][]string{
+						testRegion1: {"IPv4", "IPv4,IPv6"},
+						testRegion2: {"IPv6"},
+					},
+				},
+			},
+		},
 		{
 			name: "seed found",
 			seeds: []gardener_types.Seed{
@@ -326,7 +369,7 @@ func TestToProvideRegions(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// WHEN
-			actual := seeker.ToProviderRegions(testCase.seeds, testCase.tolerations)
+			actual := seeker.ToProviderRegions(testCase.seeds, seeker.SeedOpts{Tolerations: testCase.tolerations})
 
 			// THEN
 			require.Equal(t, testCase.expected, actual)
@@ -355,7 +398,336 @@ func taintedSeed(region string, taints ...gardener_types.SeedTaint) gardener_typ
 					Status: gardener_types.ConditionTrue,
 				},
 			},
-			LastOperation: &gardener_types.LastOperation{},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
+		},
+	}
+}
+
+func TestVerifySeedReadiness(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	withConditionsUpdated := func(seed gardener_types.Seed, lastUpdate time.Time) gardener_types.Seed {
+		seed.Status.Conditions = slices.Clone(seed.Status.Conditions)
+		for i := range seed.Status.Conditions {
+			seed.Status.Conditions[i].LastUpdateTime = metav1.NewTime(lastUpdate)
+		}
+		return seed
+	}
+	withConditionStatus := func(seed gardener_types.Seed, status gardener_types.ConditionStatus) gardener_types.Seed {
+		seed.Status.Conditions = slices.Clone(seed.Status.Conditions)
+		for i := range seed.Status.Conditions {
+			seed.Status.Conditions[i].Status = status
+		}
+		return seed
+	}
+	withLastOperation := func(seed gardener_types.Seed, opType gardener_types.LastOperationType, state gardener_types.LastOperationState) gardener_types.Seed {
+		seed.Status.LastOperation = &gardener_types.LastOperation{Type: opType, State: state}
+		return seed
+	}
+	withGenerations := func(seed gardener_types.Seed, generation, observedGeneration int64) gardener_types.Seed {
+		seed.Generation = generation
+		seed.Status.ObservedGeneration = observedGeneration
+		return seed
+	}
+	withUpdates := func(seed gardener_types.Seed, spec, status time.Time) gardener_types.Seed {
+		seed.ManagedFields = []metav1.ManagedFieldsEntry{
+			{Manager: "gardener-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: spec}},
+			{Manager: "gardenlet", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", Time: &metav1.Time{Time: status}},
+		}
+		return seed
+	}
+
+	testCases := []struct {
+		name     string
+		seed     gardener_types.Seed
+		opts     seeker.SeedOpts
+		expected bool
+	}{
+		{
+			name:     "staleness check disabled",
+			seed:     withConditionsUpdated(testSeedOK, now.Add(-24*time.Hour)),
+			expected: true,
+		},
+		{
+			name:     "fresh gardenlet condition",
+			seed:     withConditionsUpdated(testSeedOK, now.Add(-time.Minute)),
+			opts:     seeker.SeedOpts{MaxConditionAge: 5 * time.Minute},
+			expected: true,
+		},
+		{
+			name: "stale gardenlet condition",
+			seed: withConditionsUpdated(testSeedOK, now.Add(-10*time.Minute)),
+			opts: seeker.SeedOpts{MaxConditionAge: 5 * time.Minute},
+		},
+		{
+			name: "stale backup condition",
+			seed: func() gardener_types.Seed {
+				seed := withConditionsUpdated(testSeedOKWithBackup, now)
+				seed.Status.Conditions[1].LastUpdateTime = metav1.NewTime(now.Add(-10 * time.Minute))
+				return seed
+			}(),
+			opts: seeker.SeedOpts{MaxConditionAge: 5 * time.Minute},
+		},
+		{
+			name: "missing custom required condition",
+			seed: testSeedOK,
+			opts: seeker.SeedOpts{RequiredConditions: []seeker.RequiredCondition{
+				{Type: gardener_types.GardenletReady},
+				{Type: gardener_types.SeedExtensionsReady},
+			}},
+		},
+		{
+			name:     "no required conditions",
+			seed:     withConditionStatus(testSeedOK, gardener_types.ConditionFalse),
+			opts:     seeker.SeedOpts{RequiredConditions: []seeker.RequiredCondition{}},
+			expected: true,
+		},
+		{
+			name: "failed last operation",
+			seed: withLastOperation(testSeedOK, gardener_types.LastOperationTypeReconcile, gardener_types.LastOperationStateFailed),
+		},
+		{
+			name: "error last operation",
+			seed: withLastOperation(testSeedOK, gardener_types.LastOperationTypeReconcile, gardener_types.LastOperationStateError),
+		},
+		{
+			name:     "processing last operation",
+			seed:     withLastOperation(testSeedOK, gardener_types.LastOperationTypeReconcile, gardener_types.LastOperationStateProcessing),
+			expected: true,
+		},
+		{
+			name: "delete last operation",
+			seed: withLastOperation(testSeedOK, gardener_types.LastOperationTypeDelete, gardener_types.LastOperationStateSucceeded),
+		},
+		{
+			name: "error last operation accepted",
+			seed: withLastOperation(testSeedOK, gardener_types.LastOperationTypeReconcile, gardener_types.LastOperationStateError),
+			opts: seeker.SeedOpts{LastOperation: seeker.LastOperationOpts{
+				AcceptedStates: []gardener_types.LastOperationState{gardener_types.LastOperationStateSucceeded, gardener_types.LastOperationStateError},
+			}},
+			expected: true,
+		},
+		{
+			name: "delete last operation accepted",
+			seed: withLastOperation(testSeedOK, gardener_types.LastOperationTypeDelete, gardener_types.LastOperationStateSucceeded),
+			opts: seeker.SeedOpts{LastOperation: seeker.LastOperationOpts{
+				RejectedTypes: []gardener_types.LastOperationType{},
+			}},
+			expected: true,
+		},
+		{
+			name:     "lagging generation without check",
+			seed:     withGenerations(testSeedOK, 3, 1),
+			expected: true,
+		},
+		{
+			name:     "lagging generation within the allowed lag",
+			seed:     withGenerations(testSeedOK, 3, 2),
+			opts:     seeker.SeedOpts{MaxGenerationLag: ptr.To[int64](1)},
+			expected: true,
+		},
+		{
+			name: "lagging generation beyond the allowed lag",
+			seed: withGenerations(testSeedOK, 3, 1),
+			opts: seeker.SeedOpts{MaxGenerationLag: ptr.To[int64](1)},
+		},
+		{
+			name:     "lagging generation within the grace period",
+			seed:     withUpdates(withGenerations(testSeedOK, 3, 1), now.Add(-time.Minute), now),
+			opts:     seeker.SeedOpts{MaxGenerationLag: ptr.To[int64](1), GenerationLagGrace: 5 * time.Minute, Now: func() time.Time { return now }},
+			expected: true,
+		},
+		{
+			name: "lagging generation beyond the grace period",
+			seed: withUpdates(withGenerations(testSeedOK, 3, 1), now.Add(-10*time.Minute), now),
+			opts: seeker.SeedOpts{MaxGenerationLag: ptr.To[int64](1), GenerationLagGrace: 5 * time.Minute, Now: func() time.Time { return now }},
+		},
+		{
+			name:     "observed generation",
+			seed:     withGenerations(testSeedOK, 3, 3),
+			opts:     seeker.SeedOpts{MaxGenerationLag: ptr.To[int64](0)},
+			expected: true,
+		},
+		{
+			name: "progressing condition not accepted by default",
+			seed: withConditionStatus(testSeedOK, gardener_types.ConditionProgressing),
+		},
+		{
+			name: "progressing condition accepted",
+			seed: withConditionStatus(testSeedOK, gardener_types.ConditionProgressing),
+			opts: seeker.SeedOpts{RequiredConditions: []seeker.RequiredCondition{
+				{Type: gardener_types.GardenletReady, AcceptProgressing: true},
+			}},
+			expected: true,
+		},
+		{
+			name: "unknown condition accepted",
+			seed: withConditionStatus(testSeedOK, gardener_types.ConditionUnknown),
+			opts: seeker.SeedOpts{RequiredConditions: []seeker.RequiredCondition{
+				{Type: gardener_types.GardenletReady, AcceptUnknown: true},
+			}},
+			expected: true,
+		},
+		{
+			name: "unknown condition not accepted when only progressing is",
+			seed: withConditionStatus(testSeedOK, gardener_types.ConditionUnknown),
+			opts: seeker.SeedOpts{RequiredConditions: []seeker.RequiredCondition{
+				{Type: gardener_types.GardenletReady, AcceptProgressing: true},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			testCase.opts.Now = func() time.Time { return now }
+
+			// WHEN
+			actual := seeker.VerifySeedReadiness(&testCase.seed, testCase.opts)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestSeedCanBeUsedLogsConditionAges(t *testing.T) {
+	// GIVEN
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	seed := newSeed(withName("test-seed"))
+	seed.Status.Conditions = []gardener_types.Condition{
+		{Type: gardener_types.GardenletReady, Status: gardener_types.ConditionTrue, LastUpdateTime: metav1.NewTime(now.Add(-10 * time.Minute))},
+		{Type: gardener_types.SeedExtensionsReady, Status: gardener_types.ConditionFalse, LastUpdateTime: metav1.NewTime(now.Add(-3 * time.Minute))},
+	}
+	opts := seeker.SeedOpts{
+		MaxConditionAge: 5 * time.Minute,
+		RequiredConditions: []seeker.RequiredCondition{
+			{Type: gardener_types.GardenletReady},
+			{Type: gardener_types.SeedExtensionsReady},
+			{Type: gardener_types.SeedBackupBucketsReady},
+		},
+		Now: func() time.Time { return now },
+	}
+
+	// WHEN
+	usable := seeker.SeedCanBeUsed(&seed, opts)
+
+	// THEN
+	require.False(t, usable)
+	var entry struct {
+		UnsatisfiedConditions    []string          `json:"unsatisfiedConditions"`
+		UnsatisfiedConditionAges map[string]string `json:"unsatisfiedConditionAges"`
+	}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	require.Equal(t, []string{"GardenletReady", "ExtensionsReady", "BackupBucketsReady"}, entry.UnsatisfiedConditions)
+	require.Equal(t, map[string]string{"GardenletReady": "10m0s", "ExtensionsReady": "3m0s"}, entry.UnsatisfiedConditionAges)
+}
+
+func TestVerifySeedTaints(t *testing.T) {
+	taint := gardener_types.SeedTaint{Key: testTaintKey1}
+	tolerated := []gardener_types.Toleration{{Key: testTaintKey1}}
+	notTolerated := []gardener_types.Toleration{{Key: testTaintKey2}}
+
+	testCases := []struct {
+		name        string
+		tolerations config.TolerationsConfig
+		expected    bool
+	}{
+		{
+			name:        "region key",
+			tolerations: config.TolerationsConfig{testRegion1: tolerated},
+			expected:    true,
+		},
+		{
+			name:        "provider and region key",
+			tolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType1, testRegion1): tolerated},
+			expected:    true,
+		},
+		{
+			name:        "provider-wide key",
+			tolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType1, seeker.ProviderWideRegion): tolerated},
+			expected:    true,
+		},
+		{
+			name:        "other provider key",
+			tolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType2, testRegion1): tolerated},
+		},
+		{
+			name: "provider and region key takes precedence over region key",
+			tolerations: config.TolerationsConfig{
+				seeker.TolerationsKey(testProviderType1, testRegion1): notTolerated,
+				testRegion1: tolerated,
+			},
+		},
+		{
+			name: "region key takes precedence over provider-wide key",
+			tolerations: config.TolerationsConfig{
+				testRegion1: tolerated,
+				seeker.TolerationsKey(testProviderType1, seeker.ProviderWideRegion): notTolerated,
+			},
+			expected: true,
 		},
 	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			seed := taintedSeed(testRegion1, taint)
+
+			// WHEN
+			actual := seeker.VerifySeedTaints(&seed, testCase.tolerations)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestSeedCanBeUsedWithProviderTolerations(t *testing.T) {
+	tolerated := []gardener_types.Toleration{{Key: testTaintKey1}}
+	notTolerated := []gardener_types.Toleration{{Key: testTaintKey2}}
+
+	testCases := []struct {
+		name     string
+		opts     seeker.SeedOpts
+		expected bool
+	}{
+		{
+			name:     "provider scoped tolerations",
+			opts:     seeker.SeedOpts{ProviderTolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType1, seeker.ProviderWideRegion): tolerated}},
+			expected: true,
+		},
+		{
+			name: "provider and region key takes precedence over shared region key",
+			opts: seeker.SeedOpts{
+				Tolerations:         config.TolerationsConfig{testRegion1: tolerated},
+				ProviderTolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType1, testRegion1): notTolerated},
+			},
+		},
+		{
+			name: "shared region key takes precedence over provider-wide key",
+			opts: seeker.SeedOpts{
+				Tolerations:         config.TolerationsConfig{testRegion1: tolerated},
+				ProviderTolerations: config.TolerationsConfig{seeker.TolerationsKey(testProviderType1, seeker.ProviderWideRegion): notTolerated},
+			},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			seed := taintedSeed(testRegion1, gardener_types.SeedTaint{Key: testTaintKey1})
+
+			// WHEN
+			actual := seeker.SeedCanBeUsed(&seed, testCase.opts)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
 }
@@ -4,8 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/kyma-project/infrastructure-manager/pkg/config"
-
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -13,30 +11,41 @@ import (
 
 type List func(context.Context, client.ObjectList, ...client.ListOption) error
 
-type FetchSeeds func() (types.Providers, error)
+type FetchSeeds func(ctx context.Context) (types.Providers, error)
 
 type FetchSeedsOpts struct {
-	Timeout     time.Duration
-	Tolerations config.TolerationsConfig
+	Timeout time.Duration
+	SeedOpts
 	List
+	// Stabilize is optional
+	Stabilize
 }
 
+// BuildFetchSeedFn runs the default source, filters and transformer, without the optional ones configured elsewhere.
 func BuildFetchSeedFn(opts FetchSeedsOpts) FetchSeeds {
-	return func() (types.Providers, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	return func(ctx context.Context) (types.Providers, error) {
 		defer LogWithDuration(time.Now(), "fetching gardener data complete")
-		defer cancel()
 
-		seeds, err := listSeeds(ctx, opts.List)
+		seeds, err := ListSource(opts.List, opts.Timeout)(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		usable, err := EligibilityFilter(opts.SeedOpts)(ctx, seeds, seeds)
 		if err != nil {
 			return nil, err
 		}
+		if opts.Stabilize != nil {
+			if usable, err = StabilizeFilter(opts.Stabilize)(ctx, seeds, usable); err != nil {
+				return nil, err
+			}
+		}
 
-		return ToProviderRegions(seeds.Items, opts.Tolerations), nil
+		return GroupRegionsTransformer(usable, types.Providers{})
 	}
 }
 
-func listSeeds(ctx context.Context, list List) (seeds gardener_types.SeedList, err error) {
+func ListSeeds(ctx context.Context, list List) (seeds gardener_types.SeedList, err error) {
 	defer func() {
 		LogWithDuration(time.Now(), "gardener-seed list complete", "count", len(seeds.Items))
 	}()
@@ -47,3 +56,14 @@ func listSeeds(ctx context.Context, list List) (seeds gardener_types.SeedList, e
 
 	return seeds, nil
 }
+
+// ListSource provides the seeds listed from Gardener.
+func ListSource(list List, timeout time.Duration) Source {
+	return func(ctx context.Context) ([]gardener_types.Seed, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		seeds, err := ListSeeds(ctx, list)
+		return seeds.Items, err
+	}
+}
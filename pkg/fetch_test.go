@@ -22,6 +22,7 @@ func TestBuildFet(t *testing.T) {
 		name        string
 		expected    types.Providers
 		list        seeker.List
+		stabilize   seeker.Stabilize
 		expectedErr error
 	}{
 		{
@@ -56,17 +57,99 @@ func TestBuildFet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "stabilized",
+			list: buildList(gardener_types.SeedList{
+				Items: []gardener_types.Seed{
+					testSeedOK,
+					testSeedOKWithBackup,
+					testSeedNotVisible,
+				},
+			}),
+			stabilize: func(_ context.Context, seeds, usable []gardener_types.Seed) ([]gardener_types.Seed, error) {
+				return usable[1:], nil
+			},
+			expected: types.Providers{
+				testSeedOKWithBackup.Spec.Provider.Type: {
+					SeedRegions: []string{
+						testSeedOKWithBackup.Spec.Provider.Region,
+					},
+				},
+			},
+		},
+		{
+			name: "stabilize error",
+			list: buildList(gardener_types.SeedList{}),
+			stabilize: func(_ context.Context, seeds, usable []gardener_types.Seed) ([]gardener_types.Seed, error) {
+				return nil, errFetchSeedsFailedTest
+			},
+			expectedErr: errFetchSeedsFailedTest,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// GIVEN
 			fetchSeeds := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
-				List: testCase.list,
+				List:      testCase.list,
+				Stabilize: testCase.stabilize,
 			})
 
 			// WHEN
-			actual, err := fetchSeeds()
+			actual, err := fetchSeeds(context.Background())
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.EqualError(t, err, testCase.expectedErr.Error())
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestListSource(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expected    []gardener_types.Seed
+		list        seeker.List
+		expectedErr error
+	}{
+		{
+			name:        "list error",
+			list:        buildListWithError(errFetchSeedsFailedTest),
+			expectedErr: errFetchSeedsFailedTest,
+		},
+		{
+			name: "list empty",
+			list: buildList(gardener_types.SeedList{}),
+		},
+		{
+			name: "OK",
+			list: buildList(gardener_types.SeedList{
+				TypeMeta: metav1.TypeMeta{},
+				Items: []gardener_types.Seed{
+					testSeedOK,
+					testSeedOKWithBackup,
+				},
+			}),
+			expected: []gardener_types.Seed{
+				testSeedOK,
+				testSeedOKWithBackup,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			source := seeker.ListSource(testCase.list, 0)
+
+			// WHEN
+			actual, err := source(context.Background())
 
 			// THEN
 			if testCase.expectedErr != nil {
@@ -0,0 +1,23 @@
+package seeker
+
+import (
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// HATransformer marks the regions with at least one selected seed spanning minZones zones or more,
+// as highly-available control planes need a multi-zonal seed. A minZones of 0 disables it.
+func HATransformer(minZones int) Transformer {
+	return func(selected []gardener_types.Seed, providers types.Providers) (types.Providers, error) {
+		if minZones <= 0 {
+			return providers, nil
+		}
+
+		for _, seed := range selected {
+			if len(seed.Spec.Provider.Zones) >= minZones {
+				providers.MarkHA(seed.Spec.Provider.Type, seed.Spec.Provider.Region)
+			}
+		}
+		return providers, nil
+	}
+}
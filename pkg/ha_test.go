@@ -0,0 +1,50 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHATransformer(t *testing.T) {
+	selected := []gardener_types.Seed{
+		newSeed(withZones("a")),
+		newSeed(withZones("a", "b", "c")),
+		newSeed(withRegion(testRegion2), withZones("a", "b")),
+	}
+
+	testCases := []struct {
+		name     string
+		minZones int
+		expected []string
+	}{
+		{
+			name:     "three zones",
+			minZones: 3,
+			expected: []string{testRegion1},
+		},
+		{
+			name:     "two zones",
+			minZones: 2,
+			expected: []string{testRegion1, testRegion2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			providers, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+			require.NoError(t, err)
+
+			// WHEN
+			actual, err := seeker.HATransformer(testCase.minZones)(selected, providers)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual[testProviderType1].HARegions)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package seeker_test
+
+import (
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"k8s.io/utils/ptr"
+)
+
+// seedOption customizes the seed built by newSeed.
+type seedOption func(*gardener_types.Seed)
+
+// newSeed builds a visible and ready seed of testProviderType1 in testRegion1, without taints, customized by the options.
+func newSeed(opts ...seedOption) gardener_types.Seed {
+	seed := gardener_types.Seed{
+		Spec: gardener_types.SeedSpec{
+			Provider: gardener_types.SeedProvider{
+				Type:   testProviderType1,
+				Region: testRegion1,
+			},
+			Settings: &gardener_types.SeedSettings{
+				Scheduling: &gardener_types.SeedSettingScheduling{
+					Visible: true,
+				},
+			},
+		},
+		Status: gardener_types.SeedStatus{
+			Conditions: []gardener_types.Condition{
+				{
+					Type:   gardener_types.GardenletReady,
+					Status: gardener_types.ConditionTrue,
+				},
+			},
+			LastOperation: &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded},
+		},
+	}
+	for _, opt := range opts {
+		opt(&seed)
+	}
+	return seed
+}
+
+func withName(name string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Name = name
+	}
+}
+
+// withManaged sets the classification of the seed as ManagedSeed, see seeker.ManagedSeedSource.
+func withManaged(value string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Annotations = map[string]string{seeker.ManagedSeedAnnotation: value}
+	}
+}
+
+func withProvider(provider string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Provider.Type = provider
+	}
+}
+
+func withRegion(region string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Provider.Region = region
+	}
+}
+
+func withLabels(labels map[string]string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Labels = labels
+	}
+}
+
+func withZones(zones ...string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Provider.Zones = zones
+	}
+}
+
+func withNetworks(networks gardener_types.SeedNetworks) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Networks = networks
+	}
+}
+
+func withIPFamilies(families ...gardener_types.IPFamily) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Networks.IPFamilies = families
+	}
+}
+
+// withVersions sets the gardenlet and Kubernetes versions of the seed, empty ones are left unset.
+func withVersions(gardenerVersion, kubernetesVersion string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		if gardenerVersion != "" {
+			seed.Status.Gardener = &gardener_types.Gardener{Version: gardenerVersion}
+		}
+		if kubernetesVersion != "" {
+			seed.Status.KubernetesVersion = ptr.To(kubernetesVersion)
+		}
+	}
+}
+
+func withTaints(taints ...gardener_types.SeedTaint) seedOption {
+	return func(seed *gardener_types.Seed) {
+		seed.Spec.Taints = taints
+	}
+}
+
+func withAccessRestrictions(names ...string) seedOption {
+	return func(seed *gardener_types.Seed) {
+		for _, name := range names {
+			seed.Spec.AccessRestrictions = append(seed.Spec.AccessRestrictions, gardener_types.AccessRestriction{Name: name})
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package seeker
+
+import (
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// LabelsTransformer publishes the distinct values of the selected seed labels with the given keys per region.
+// Seeds without the label do not add a value. Without keys, it does nothing.
+func LabelsTransformer(keys []string) Transformer {
+	return func(selected []gardener_types.Seed, providers types.Providers) (types.Providers, error) {
+		for _, seed := range selected {
+			for _, key := range keys {
+				if value, found := seed.Labels[key]; found {
+					providers.AddLabel(seed.Spec.Provider.Type, seed.Spec.Provider.Region, key, value)
+				}
+			}
+		}
+		return providers, nil
+	}
+}
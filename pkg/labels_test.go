@@ -0,0 +1,55 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelsTransformer(t *testing.T) {
+	selected := []gardener_types.Seed{
+		newSeed(withLabels(map[string]string{"environment": "prod", "purpose": "kyma"})),
+		newSeed(withLabels(map[string]string{"environment": "canary", "purpose": "kyma"})),
+		newSeed(withRegion(testRegion2), withLabels(map[string]string{"hardware": "arm"})),
+		newSeed(withRegion(testRegion3)),
+	}
+
+	testCases := []struct {
+		name     string
+		keys     []string
+		expected map[string]map[string][]string
+	}{
+		{
+			name: "configured keys",
+			keys: []string{"environment", "purpose"},
+			expected: map[string]map[string][]string{
+				testRegion1: {
+					"environment": {"canary", "prod"},
+					"purpose":     {"kyma"},
+				},
+			},
+		},
+		{
+			name: "unknown key",
+			keys: []string{"unknown"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			providers, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+			require.NoError(t, err)
+
+			// WHEN
+			actual, err := seeker.LabelsTransformer(testCase.keys)(selected, providers)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual[testProviderType1].Labels)
+		})
+	}
+}
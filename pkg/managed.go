@@ -0,0 +1,115 @@
+package seeker
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManagedSeedAnnotation is set by the ManagedSeedSource on every seed, to "true" for a ManagedSeed
+// (a shoot registered as seed) and to "false" for a dedicated seed cluster. It is also visible to the seed rules.
+const ManagedSeedAnnotation = "gardener-syncer.kyma-project.io/managed-seed"
+
+// ManagedSeedPolicy selects the seeds that can be used by whether they are ManagedSeeds.
+type ManagedSeedPolicy string
+
+const (
+	// ManagedSeedsAny uses both ManagedSeeds and dedicated seeds.
+	ManagedSeedsAny ManagedSeedPolicy = ""
+	// ManagedSeedsOnly uses only ManagedSeeds.
+	ManagedSeedsOnly ManagedSeedPolicy = "only"
+	// ManagedSeedsExclude uses only dedicated seeds.
+	ManagedSeedsExclude ManagedSeedPolicy = "exclude"
+)
+
+// accepts reports whether the policy allows the seed, seeds not classified by the ManagedSeedSource are always allowed.
+func (policy ManagedSeedPolicy) accepts(seed *gardener_types.Seed) bool {
+	managed, known := IsManagedSeed(seed)
+	if !known {
+		return true
+	}
+
+	switch policy {
+	case ManagedSeedsOnly:
+		return managed
+	case ManagedSeedsExclude:
+		return !managed
+	default:
+		return true
+	}
+}
+
+// IsManagedSeed returns whether the seed is a ManagedSeed, and false as second value if the seed was not classified.
+func IsManagedSeed(seed *gardener_types.Seed) (managed bool, known bool) {
+	value, found := seed.Annotations[ManagedSeedAnnotation]
+	if !found {
+		return false, false
+	}
+	managed, err := strconv.ParseBool(value)
+	return managed, err == nil
+}
+
+// ListManagedSeeds lists the ManagedSeeds of the garden namespace, where they are registered.
+func ListManagedSeeds(ctx context.Context, list List) (managedSeeds seedmanagement.ManagedSeedList, err error) {
+	defer func() {
+		LogWithDuration(time.Now(), "gardener-managedseed list complete", "count", len(managedSeeds.Items))
+	}()
+
+	if err = list(ctx, &managedSeeds, client.InNamespace(v1beta1constants.GardenNamespace)); err != nil {
+		return seedmanagement.ManagedSeedList{}, err
+	}
+
+	return managedSeeds, nil
+}
+
+// AnnotateManagedSeeds classifies the seeds with the ManagedSeedAnnotation, a seed is managed if a ManagedSeed of the same name exists.
+func AnnotateManagedSeeds(seeds []gardener_types.Seed, managedSeeds []seedmanagement.ManagedSeed) {
+	names := map[string]struct{}{}
+	for _, managedSeed := range managedSeeds {
+		names[managedSeed.Name] = struct{}{}
+	}
+
+	for i := range seeds {
+		_, managed := names[seeds[i].Name]
+		if seeds[i].Annotations == nil {
+			seeds[i].Annotations = map[string]string{}
+		}
+		seeds[i].Annotations[ManagedSeedAnnotation] = strconv.FormatBool(managed)
+		slog.Debug("seed classified", "name", seeds[i].Name, "managed", managed)
+	}
+}
+
+// ManagedSeedSource provides the seeds listed from Gardener, classified with the ManagedSeedAnnotation.
+func ManagedSeedSource(list List, timeout time.Duration) Source {
+	return func(ctx context.Context) ([]gardener_types.Seed, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		seeds, err := ListSeeds(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+
+		managedSeeds, err := ListManagedSeeds(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+
+		AnnotateManagedSeeds(seeds.Items, managedSeeds.Items)
+		return seeds.Items, nil
+	}
+}
+
+// managedSeedLogArgs adds the classification of the seed to the log arguments, if the seed was classified.
+func managedSeedLogArgs(seed *gardener_types.Seed, args ...any) []any {
+	if managed, known := IsManagedSeed(seed); known {
+		args = append(args, "managed", managed)
+	}
+	return args
+}
@@ -0,0 +1,90 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestManagedSeedSource(t *testing.T) {
+	// GIVEN
+	managed := newSeed(withName("managed-seed"))
+	dedicated := newSeed(withName("dedicated-seed"))
+	list := func(_ context.Context, ol client.ObjectList, _ ...client.ListOption) error {
+		switch typed := ol.(type) {
+		case *gardener_types.SeedList:
+			typed.Items = []gardener_types.Seed{managed, dedicated}
+		case *seedmanagement.ManagedSeedList:
+			typed.Items = []seedmanagement.ManagedSeed{{ObjectMeta: metav1.ObjectMeta{Name: "managed-seed"}}}
+		}
+		return nil
+	}
+
+	// WHEN
+	seeds, err := seeker.ManagedSeedSource(list, 0)(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Len(t, seeds, 2)
+	require.Equal(t, "true", seeds[0].Annotations[seeker.ManagedSeedAnnotation])
+	require.Equal(t, "false", seeds[1].Annotations[seeker.ManagedSeedAnnotation])
+}
+
+func TestSeedCanBeUsedWithManagedSeeds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seed     gardener_types.Seed
+		policy   seeker.ManagedSeedPolicy
+		expected bool
+	}{
+		{
+			name:     "any managed seed",
+			seed:     newSeed(withManaged("true")),
+			expected: true,
+		},
+		{
+			name:     "only managed seeds, managed seed",
+			seed:     newSeed(withManaged("true")),
+			policy:   seeker.ManagedSeedsOnly,
+			expected: true,
+		},
+		{
+			name:   "only managed seeds, dedicated seed",
+			seed:   newSeed(withManaged("false")),
+			policy: seeker.ManagedSeedsOnly,
+		},
+		{
+			name:   "excluded managed seeds, managed seed",
+			seed:   newSeed(withManaged("true")),
+			policy: seeker.ManagedSeedsExclude,
+		},
+		{
+			name:     "excluded managed seeds, dedicated seed",
+			seed:     newSeed(withManaged("false")),
+			policy:   seeker.ManagedSeedsExclude,
+			expected: true,
+		},
+		{
+			name:     "not classified seed",
+			seed:     newSeed(),
+			policy:   seeker.ManagedSeedsOnly,
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.SeedCanBeUsed(&testCase.seed, seeker.SeedOpts{ManagedSeeds: testCase.policy})
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package seeker
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// ProviderMapping maps a Gardener provider type and its regions to the names used by the Kyma platform.
+type ProviderMapping struct {
+	// Name defaults to the provider type. Provider types mapped to the same name are merged.
+	Name string `json:"name,omitempty"`
+	// Regions maps Gardener region names to Kyma region names, unmapped regions keep their names.
+	Regions map[string]string `json:"regions,omitempty"`
+}
+
+// MappingOpts configures the names of the providers and regions in the result.
+type MappingOpts struct {
+	// Providers is keyed by the Gardener provider type.
+	Providers map[string]ProviderMapping `json:"providers,omitempty"`
+	// DropUnknownProviders removes the providers without mapping from the result.
+	DropUnknownProviders bool `json:"dropUnknownProviders,omitempty"`
+}
+
+// MappingTransformer renames the providers and regions of the result.
+// It has to run after the transformers relying on the Gardener names, like the redundancy transformer.
+func MappingTransformer(opts MappingOpts) Transformer {
+	return func(_ []gardener_types.Seed, providers types.Providers) (types.Providers, error) {
+		merged := opts.mergedRegions(providers)
+		out := types.Providers{}
+		// sorted, so the regions of merged providers are always in the same order
+		for _, provider := range slices.Sorted(maps.Keys(providers)) {
+			name, mapping, found := opts.provider(provider)
+			if !found {
+				slog.Info("provider dropped, no mapping", "provider", provider)
+				continue
+			}
+
+			info := providers[provider]
+			for _, region := range info.SeedRegions {
+				out.Add(name, mapping.region(region))
+			}
+			// a region merged out of several regions is backed by several seeds
+			for _, region := range info.SingleSeedRegions {
+				if merged[name][mapping.region(region)] == 1 {
+					out.MarkSingleSeed(name, mapping.region(region))
+				}
+			}
+			// a merged region has a multi-zonal seed if any of its regions has one
+			for _, region := range info.HARegions {
+				out.MarkHA(name, mapping.region(region))
+			}
+			for _, region := range slices.Sorted(maps.Keys(info.IPFamilies)) {
+				for _, stack := range info.IPFamilies[region] {
+					out.AddIPFamilies(name, mapping.region(region), stack)
+				}
+			}
+			for _, region := range slices.Sorted(maps.Keys(info.Labels)) {
+				for key, values := range info.Labels[region] {
+					for _, value := range values {
+						out.AddLabel(name, mapping.region(region), key, value)
+					}
+				}
+			}
+			for _, restriction := range slices.Sorted(maps.Keys(info.AccessRestrictions)) {
+				for _, region := range info.AccessRestrictions[restriction] {
+					out.AddAccessRestriction(name, restriction, mapping.region(region))
+				}
+			}
+		}
+		return out, nil
+	}
+}
+
+// mergedRegions counts the regions mapped to each provider name and region.
+func (opts MappingOpts) mergedRegions(providers types.Providers) map[string]map[string]int {
+	out := map[string]map[string]int{}
+	for provider, info := range providers {
+		name, mapping, found := opts.provider(provider)
+		if !found {
+			continue
+		}
+		if out[name] == nil {
+			out[name] = map[string]int{}
+		}
+		for _, region := range info.SeedRegions {
+			out[name][mapping.region(region)]++
+		}
+	}
+	return out
+}
+
+// mappedCounts sums the seed counts of the provider types and regions mapped to the same provider name and region.
+func (opts MappingOpts) mappedCounts(counts SeedCounts) SeedCounts {
+	out := SeedCounts{}
+	for provider, regions := range counts {
+		name, mapping, found := opts.provider(provider)
+		if !found {
+			continue
+		}
+		if out[name] == nil {
+			out[name] = map[string]int{}
+		}
+		for region, count := range regions {
+			out[name][mapping.region(region)] += count
+		}
+	}
+	return out
+}
+
+// count returns the mapped seed count of the Gardener provider type and region, 0 if the provider is dropped.
+func (opts MappingOpts) count(counts SeedCounts, provider, region string) int {
+	name, mapping, found := opts.provider(provider)
+	if !found {
+		return 0
+	}
+	return counts[name][mapping.region(region)]
+}
+
+// provider returns the mapped name and the mapping of the provider type, false if the provider is dropped.
+func (opts MappingOpts) provider(provider string) (string, ProviderMapping, bool) {
+	mapping, found := opts.Providers[provider]
+	if !found && opts.DropUnknownProviders {
+		return "", mapping, false
+	}
+
+	if mapping.Name != "" {
+		return mapping.Name, mapping, true
+	}
+	return provider, mapping, true
+}
+
+func (mapping ProviderMapping) region(region string) string {
+	if mapped, found := mapping.Regions[region]; found {
+		return mapped
+	}
+	return region
+}
@@ -0,0 +1,113 @@
+package seeker_test
+
+import (
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappingTransformer(t *testing.T) {
+	providers := types.Providers{
+		testProviderType1: {
+			SeedRegions:       []string{testRegion1, testRegion2},
+			SingleSeedRegions: []string{testRegion2},
+			Labels:            map[string]map[string][]string{testRegion2: {"environment": {"prod"}}},
+		},
+		testProviderType2: {SeedRegions: []string{testRegion3}, IPFamilies: map[string][]string{testRegion3: {"IPv4,IPv6"}}},
+	}
+
+	testCases := []struct {
+		name     string
+		opts     seeker.MappingOpts
+		expected types.Providers
+	}{
+		{
+			name:     "no mapping",
+			expected: providers,
+		},
+		{
+			name: "renamed provider and region",
+			opts: seeker.MappingOpts{Providers: map[string]seeker.ProviderMapping{
+				testProviderType1: {Name: "kyma-provider", Regions: map[string]string{testRegion2: "kyma-region"}},
+			}},
+			expected: types.Providers{
+				"kyma-provider": {
+					SeedRegions:       []string{testRegion1, "kyma-region"},
+					SingleSeedRegions: []string{"kyma-region"},
+					Labels:            map[string]map[string][]string{"kyma-region": {"environment": {"prod"}}},
+				},
+				testProviderType2: {SeedRegions: []string{testRegion3}, IPFamilies: map[string][]string{testRegion3: {"IPv4,IPv6"}}},
+			},
+		},
+		{
+			name: "merged providers",
+			opts: seeker.MappingOpts{Providers: map[string]seeker.ProviderMapping{
+				testProviderType1: {Name: "kyma-provider"},
+				testProviderType2: {Name: "kyma-provider"},
+			}},
+			expected: types.Providers{
+				"kyma-provider": {
+					SeedRegions:       []string{testRegion1, testRegion2, testRegion3},
+					SingleSeedRegions: []string{testRegion2},
+					IPFamilies:        map[string][]string{testRegion3: {"IPv4,IPv6"}},
+					Labels:            map[string]map[string][]string{testRegion2: {"environment": {"prod"}}},
+				},
+			},
+		},
+		{
+			name: "dropped unknown provider",
+			opts: seeker.MappingOpts{
+				Providers:            map[string]seeker.ProviderMapping{testProviderType2: {}},
+				DropUnknownProviders: true,
+			},
+			expected: types.Providers{
+				testProviderType2: {SeedRegions: []string{testRegion3}, IPFamilies: map[string][]string{testRegion3: {"IPv4,IPv6"}}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := seeker.MappingTransformer(testCase.opts)(nil, providers)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestMappingTransformerMergedSingleSeedRegions(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{
+		testProviderType1: {
+			SeedRegions:       []string{testRegion1, testRegion2},
+			SingleSeedRegions: []string{testRegion1, testRegion2},
+			HARegions:         []string{testRegion2},
+		},
+		testProviderType2: {
+			SeedRegions:       []string{testRegion3},
+			SingleSeedRegions: []string{testRegion3},
+		},
+	}
+	opts := seeker.MappingOpts{Providers: map[string]seeker.ProviderMapping{
+		testProviderType1: {Name: "kyma-provider", Regions: map[string]string{testRegion2: "kyma-region"}},
+		testProviderType2: {Name: "kyma-provider", Regions: map[string]string{testRegion3: "kyma-region"}},
+	}}
+
+	// WHEN
+	actual, err := seeker.MappingTransformer(opts)(nil, providers)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, types.Providers{
+		"kyma-provider": {
+			SeedRegions:       []string{testRegion1, "kyma-region"},
+			SingleSeedRegions: []string{testRegion1},
+			HARegions:         []string{"kyma-region"},
+		},
+	}, actual)
+}
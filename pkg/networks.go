@@ -0,0 +1,90 @@
+package seeker
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type NetworksOpts struct {
+	Timeout time.Duration
+	Key     client.ObjectKey
+	// Mapping is applied to the providers and regions, like to the seed regions.
+	Mapping MappingOpts
+	Patch
+}
+
+// BuildSeedNetworks lists the network ranges of the selected seeds per provider, sorted by region and seed name.
+func BuildSeedNetworks(selected []gardener_types.Seed, mapping MappingOpts) types.SeedNetworks {
+	out := types.SeedNetworks{}
+	for _, seed := range selected {
+		name, providerMapping, found := mapping.provider(seed.Spec.Provider.Type)
+		if !found {
+			continue
+		}
+
+		networks := seed.Spec.Networks
+		network := types.SeedNetwork{
+			Name:     seed.Name,
+			Region:   providerMapping.region(seed.Spec.Provider.Region),
+			Nodes:    ptr.Deref(networks.Nodes, ""),
+			Pods:     networks.Pods,
+			Services: networks.Services,
+		}
+		if networks.ShootDefaults != nil {
+			network.ShootDefaultPods = ptr.Deref(networks.ShootDefaults.Pods, "")
+			network.ShootDefaultServices = ptr.Deref(networks.ShootDefaults.Services, "")
+		}
+		out[name] = append(out[name], network)
+	}
+
+	for _, networks := range out {
+		slices.SortFunc(networks, func(a, b types.SeedNetwork) int {
+			return cmp.Or(cmp.Compare(a.Region, b.Region), cmp.Compare(a.Name, b.Name))
+		})
+	}
+	return out
+}
+
+func SeedNetworksToConfigMap(networks types.SeedNetworks) (map[string]string, error) {
+	result := map[string]string{}
+	for provider, seeds := range networks {
+		data, err := yaml.Marshal(seeds)
+		if err != nil {
+			return nil, err
+		}
+		result[provider] = strings.TrimRight(string(data), "\n")
+	}
+	return result, nil
+}
+
+// NetworksSink stores the network ranges of the selected seeds in a config map.
+func NetworksSink(opts NetworksOpts) Sink {
+	return func(ctx context.Context, selected []gardener_types.Seed, _ types.Providers) error {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+		defer LogWithDuration(time.Now(), "storing seed networks complete", "key", opts.Key)
+
+		data, err := SeedNetworksToConfigMap(BuildSeedNetworks(selected, opts.Mapping))
+		if err != nil {
+			return err
+		}
+
+		cm := corev1.ConfigMap{Data: data}
+		cm.Name = opts.Key.Name
+		cm.Namespace = opts.Key.Namespace
+		cm.TypeMeta.Kind = "ConfigMap"
+		cm.TypeMeta.APIVersion = "v1"
+
+		return applyConfigMap(ctx, opts.Patch, &cm)
+	}
+}
@@ -0,0 +1,110 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBuildSeedNetworks(t *testing.T) {
+	seeds := []gardener_types.Seed{
+		newSeed(withName("seed-b"), withRegion(testRegion1), withNetworks(gardener_types.SeedNetworks{
+			Nodes:    ptr.To("10.250.0.0/16"),
+			Pods:     "100.64.0.0/12",
+			Services: "100.104.0.0/13",
+			ShootDefaults: &gardener_types.ShootNetworks{
+				Pods:     ptr.To("100.96.0.0/11"),
+				Services: ptr.To("100.64.0.0/13"),
+			},
+		})),
+		newSeed(withName("seed-c"), withRegion(testRegion2), withNetworks(gardener_types.SeedNetworks{Pods: "10.1.0.0/16", Services: "10.2.0.0/16"})),
+		newSeed(withName("seed-a"), withRegion(testRegion1), withNetworks(gardener_types.SeedNetworks{Pods: "10.3.0.0/16", Services: "10.4.0.0/16"})),
+	}
+
+	testCases := []struct {
+		name     string
+		mapping  seeker.MappingOpts
+		expected types.SeedNetworks
+	}{
+		{
+			name: "without mapping",
+			expected: types.SeedNetworks{
+				testProviderType1: {
+					{Name: "seed-a", Region: testRegion1, Pods: "10.3.0.0/16", Services: "10.4.0.0/16"},
+					{
+						Name:                 "seed-b",
+						Region:               testRegion1,
+						Nodes:                "10.250.0.0/16",
+						Pods:                 "100.64.0.0/12",
+						Services:             "100.104.0.0/13",
+						ShootDefaultPods:     "100.96.0.0/11",
+						ShootDefaultServices: "100.64.0.0/13",
+					},
+					{Name: "seed-c", Region: testRegion2, Pods: "10.1.0.0/16", Services: "10.2.0.0/16"},
+				},
+			},
+		},
+		{
+			name: "with mapping",
+			mapping: seeker.MappingOpts{
+				Providers: map[string]seeker.ProviderMapping{
+					testProviderType1: {Name: "kyma-provider", Regions: map[string]string{testRegion2: "kyma-region"}},
+				},
+			},
+			expected: types.SeedNetworks{
+				"kyma-provider": {
+					{Name: "seed-c", Region: "kyma-region", Pods: "10.1.0.0/16", Services: "10.2.0.0/16"},
+					{Name: "seed-a", Region: testRegion1, Pods: "10.3.0.0/16", Services: "10.4.0.0/16"},
+					{
+						Name:                 "seed-b",
+						Region:               testRegion1,
+						Nodes:                "10.250.0.0/16",
+						Pods:                 "100.64.0.0/12",
+						Services:             "100.104.0.0/13",
+						ShootDefaultPods:     "100.96.0.0/11",
+						ShootDefaultServices: "100.64.0.0/13",
+					},
+				},
+			},
+		},
+		{
+			name: "provider dropped",
+			mapping: seeker.MappingOpts{
+				DropUnknownProviders: true,
+			},
+			expected: types.SeedNetworks{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.BuildSeedNetworks(seeds, testCase.mapping)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestNetworksSink(t *testing.T) {
+	// GIVEN
+	sink := seeker.NetworksSink(seeker.NetworksOpts{
+		Key:   client.ObjectKey{Name: "test-networks", Namespace: "test-namespace"},
+		Patch: buildPatch("test-networks", "test-namespace", stringMap{testProviderType1: "- name: test-seed\n  pods: 10.1.0.0/16\n  region: test-region1\n  services: 10.2.0.0/16"}),
+	})
+
+	// WHEN
+	err := sink(context.Background(), []gardener_types.Seed{
+		newSeed(withName("test-seed"), withRegion(testRegion1), withNetworks(gardener_types.SeedNetworks{Pods: "10.1.0.0/16", Services: "10.2.0.0/16"})),
+	}, nil)
+
+	// THEN
+	require.NoError(t, err)
+}
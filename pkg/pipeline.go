@@ -0,0 +1,210 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// Names of the stages registered by default.
+const (
+	StageGardener     = "gardener"
+	StageEligibility  = "eligibility"
+	StageStabilize    = "stabilize"
+	StageCloudProfile = "cloudprofile"
+	StageGroupRegions = "group-regions"
+	StageHA           = "ha"
+	StageLabels       = "labels"
+	StageRedundancy   = "redundancy"
+	StageMapping      = "mapping"
+	StageConfigMap    = "configmap"
+	StageCatalog      = "catalog"
+	StageNetworks     = "networks"
+)
+
+var ErrInvalidPipeline = errors.New("invalid pipeline")
+
+// Source provides seeds to synchronise.
+type Source func(ctx context.Context) ([]gardener_types.Seed, error)
+
+// Filter selects seeds out of all provided seeds and the ones selected by the previous filters.
+type Filter func(ctx context.Context, seeds, selected []gardener_types.Seed) ([]gardener_types.Seed, error)
+
+// Transformer builds the result out of the selected seeds and the result of the previous transformers.
+type Transformer func(selected []gardener_types.Seed, providers types.Providers) (types.Providers, error)
+
+// Sink publishes the result, the selected seeds are passed for the outputs built from them directly.
+type Sink func(ctx context.Context, selected []gardener_types.Seed, providers types.Providers) error
+
+// PipelineConfig lists the names of the stages to compose, in the order they are run.
+// The seeds of all sources are concatenated and the result is published to all sinks.
+type PipelineConfig struct {
+	Sources      []string `json:"sources,omitempty"`
+	Filters      []string `json:"filters,omitempty"`
+	Transformers []string `json:"transformers,omitempty"`
+	Sinks        []string `json:"sinks,omitempty"`
+}
+
+// Registry holds the named stages a pipeline can be composed of.
+type Registry struct {
+	sources      map[string]Source
+	filters      map[string]Filter
+	transformers map[string]Transformer
+	sinks        map[string]Sink
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		sources:      map[string]Source{},
+		filters:      map[string]Filter{},
+		transformers: map[string]Transformer{},
+		sinks:        map[string]Sink{},
+	}
+}
+
+func register[T any](stages map[string]T, kind, name string, stage T) error {
+	if _, found := stages[name]; found {
+		return fmt.Errorf("%s %q already registered", kind, name)
+	}
+	stages[name] = stage
+	return nil
+}
+
+func (r *Registry) RegisterSource(name string, source Source) error {
+	return register(r.sources, "source", name, source)
+}
+
+func (r *Registry) RegisterFilter(name string, filter Filter) error {
+	return register(r.filters, "filter", name, filter)
+}
+
+func (r *Registry) RegisterTransformer(name string, transformer Transformer) error {
+	return register(r.transformers, "transformer", name, transformer)
+}
+
+func (r *Registry) RegisterSink(name string, sink Sink) error {
+	return register(r.sinks, "sink", name, sink)
+}
+
+func lookup[T any](stages map[string]T, kind string, names []string) ([]T, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: no %s configured", ErrInvalidPipeline, kind)
+	}
+
+	out := make([]T, 0, len(names))
+	for _, name := range names {
+		stage, found := stages[name]
+		if !found {
+			return nil, fmt.Errorf("%w: unknown %s %q", ErrInvalidPipeline, kind, name)
+		}
+		out = append(out, stage)
+	}
+	return out, nil
+}
+
+// Build composes the configured stages into a synchronisation.
+// Filters are optional, at least one source, transformer and sink is required.
+func (r *Registry) Build(cfg PipelineConfig) (Sync, error) {
+	sources, err := lookup(r.sources, "source", cfg.Sources)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []Filter
+	if len(cfg.Filters) > 0 {
+		if filters, err = lookup(r.filters, "filter", cfg.Filters); err != nil {
+			return nil, err
+		}
+	}
+
+	transformers, err := lookup(r.transformers, "transformer", cfg.Transformers)
+	if err != nil {
+		return nil, err
+	}
+
+	sinks, err := lookup(r.sinks, "sink", cfg.Sinks)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		defer LogWithDuration(time.Now(), "synchronisation complete")
+
+		ctx = WithSyncScope(ctx)
+
+		var seeds []gardener_types.Seed
+		for _, source := range sources {
+			provided, err := source(ctx)
+			if err != nil {
+				return err
+			}
+			seeds = append(seeds, provided...)
+		}
+
+		selected := seeds
+		for _, filter := range filters {
+			if selected, err = filter(ctx, seeds, selected); err != nil {
+				return err
+			}
+		}
+
+		providers := types.Providers{}
+		for _, transformer := range transformers {
+			if providers, err = transformer(selected, providers); err != nil {
+				return err
+			}
+		}
+
+		for _, sink := range sinks {
+			if err := sink(ctx, selected, providers); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// DefaultPipelineConfig is the pipeline of the default stages, extended with the optional stages enabled,
+// e.g. the filters StageCloudProfile and StageStabilize, which has to be the last one, or the sinks StageCatalog, StageNetworks
+// and StageStabilize, which has to be the last one too.
+func DefaultPipelineConfig(optional PipelineConfig) PipelineConfig {
+	return PipelineConfig{
+		Sources:      append([]string{StageGardener}, optional.Sources...),
+		Filters:      append([]string{StageEligibility}, optional.Filters...),
+		Transformers: append([]string{StageGroupRegions, StageHA, StageLabels, StageRedundancy, StageMapping}, optional.Transformers...),
+		Sinks:        append([]string{StageConfigMap}, optional.Sinks...),
+	}
+}
+
+// EligibilityFilter selects the usable seeds.
+func EligibilityFilter(opts SeedOpts) Filter {
+	return func(_ context.Context, _, selected []gardener_types.Seed) ([]gardener_types.Seed, error) {
+		return UsableSeeds(selected, opts), nil
+	}
+}
+
+// StabilizeFilter postpones the eligibility changes of the selected seeds.
+func StabilizeFilter(stabilize Stabilize) Filter {
+	return Filter(stabilize)
+}
+
+// GroupRegionsTransformer adds the regions of the selected seeds to the result.
+func GroupRegionsTransformer(selected []gardener_types.Seed, providers types.Providers) (types.Providers, error) {
+	defer LogWithDuration(time.Now(), "conversion complete")
+
+	for _, seed := range selected {
+		addSeedRegion(providers, seed)
+	}
+	return providers, nil
+}
+
+// StoreSink publishes the result with the given store.
+func StoreSink(store Store) Sink {
+	return func(ctx context.Context, _ []gardener_types.Seed, providers types.Providers) error {
+		return store(ctx, providers)
+	}
+}
@@ -0,0 +1,160 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryBuild(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pipeline    seeker.PipelineConfig
+		list        seeker.List
+		expected    types.Providers
+		expectedErr error
+	}{
+		{
+			name:     "default pipeline",
+			pipeline: seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			list: buildList(gardener_types.SeedList{
+				Items: []gardener_types.Seed{testSeedOK, testSeedNotVisible},
+			}),
+			expected: types.Providers{
+				testSeedOK.Spec.Provider.Type: {SeedRegions: []string{testSeedOK.Spec.Provider.Region}},
+			},
+		},
+		{
+			name: "custom pipeline",
+			pipeline: seeker.PipelineConfig{
+				Sources:      []string{seeker.StageGardener, "static"},
+				Filters:      []string{"first"},
+				Transformers: []string{seeker.StageGroupRegions},
+				Sinks:        []string{seeker.StageConfigMap},
+			},
+			list: buildList(gardener_types.SeedList{
+				Items: []gardener_types.Seed{testSeedNotVisible},
+			}),
+			expected: types.Providers{
+				testSeedNotVisible.Spec.Provider.Type: {SeedRegions: []string{testSeedNotVisible.Spec.Provider.Region}},
+			},
+		},
+		{
+			name:        "source error",
+			pipeline:    seeker.DefaultPipelineConfig(seeker.PipelineConfig{}),
+			list:        buildListWithError(errFetchSeedsFailedTest),
+			expectedErr: errFetchSeedsFailedTest,
+		},
+		{
+			name:        "unregistered stage",
+			pipeline:    seeker.DefaultPipelineConfig(seeker.PipelineConfig{Filters: []string{seeker.StageStabilize}}),
+			expectedErr: seeker.ErrInvalidPipeline,
+		},
+		{
+			name: "no sink",
+			pipeline: seeker.PipelineConfig{
+				Sources:      []string{seeker.StageGardener},
+				Transformers: []string{seeker.StageGroupRegions},
+			},
+			expectedErr: seeker.ErrInvalidPipeline,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			var actual types.Providers
+			registry := seeker.NewRegistry()
+			require.NoError(t, registry.RegisterSource(seeker.StageGardener, seeker.ListSource(testCase.list, 0)))
+			require.NoError(t, registry.RegisterSource("static", func(context.Context) ([]gardener_types.Seed, error) {
+				return []gardener_types.Seed{testSeedOK}, nil
+			}))
+			require.NoError(t, registry.RegisterFilter(seeker.StageEligibility, seeker.EligibilityFilter(seeker.SeedOpts{})))
+			require.NoError(t, registry.RegisterFilter("first", func(_ context.Context, _, selected []gardener_types.Seed) ([]gardener_types.Seed, error) {
+				return selected[:1], nil
+			}))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageGroupRegions, seeker.GroupRegionsTransformer))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageHA, seeker.HATransformer(0)))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageLabels, seeker.LabelsTransformer(nil)))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageRedundancy, seeker.RedundancyTransformer(seeker.RedundancyOpts{}, seeker.MappingOpts{})))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageMapping, seeker.MappingTransformer(seeker.MappingOpts{})))
+			require.NoError(t, registry.RegisterSink(seeker.StageConfigMap, func(_ context.Context, _ []gardener_types.Seed, providers types.Providers) error {
+				actual = providers
+				return nil
+			}))
+
+			// WHEN
+			sync, err := registry.Build(testCase.pipeline)
+			if err == nil {
+				err = sync(context.Background())
+			}
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRegistryRegisterDuplicate(t *testing.T) {
+	// GIVEN
+	registry := seeker.NewRegistry()
+	require.NoError(t, registry.RegisterSink(seeker.StageConfigMap, func(context.Context, []gardener_types.Seed, types.Providers) error { return nil }))
+
+	// WHEN
+	err := registry.RegisterSink(seeker.StageConfigMap, func(context.Context, []gardener_types.Seed, types.Providers) error { return nil })
+
+	// THEN
+	require.ErrorContains(t, err, `sink "configmap" already registered`)
+}
+
+func TestTransformersDisabled(t *testing.T) {
+	selected := []gardener_types.Seed{
+		newSeed(withZones("a", "b", "c"), withLabels(map[string]string{"environment": "prod"})),
+		newSeed(withRegion(testRegion2)),
+		newSeed(withProvider(testProviderType2)),
+	}
+
+	testCases := []struct {
+		name        string
+		transformer seeker.Transformer
+	}{
+		{
+			name:        seeker.StageHA,
+			transformer: seeker.HATransformer(0),
+		},
+		{
+			name:        seeker.StageLabels,
+			transformer: seeker.LabelsTransformer(nil),
+		},
+		{
+			name:        seeker.StageRedundancy,
+			transformer: seeker.RedundancyTransformer(seeker.RedundancyOpts{}, seeker.MappingOpts{}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			providers, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+			require.NoError(t, err)
+			expected, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+			require.NoError(t, err)
+
+			// WHEN
+			actual, err := testCase.transformer(selected, providers)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+}
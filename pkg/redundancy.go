@@ -0,0 +1,67 @@
+package seeker
+
+import (
+	"log/slog"
+	"slices"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// RedundancyOpts configures the minimum number of usable seeds a region needs to be published.
+type RedundancyOpts struct {
+	// MinSeeds applies to all providers, values below 2 disable the check.
+	MinSeeds int `json:"minSeeds,omitempty"`
+	// Providers overrides MinSeeds per provider type.
+	Providers map[string]int `json:"providers,omitempty"`
+	// MarkSingleSeed lists the published regions backed by a single seed in the output.
+	MarkSingleSeed bool `json:"markSingleSeed,omitempty"`
+}
+
+func (opts RedundancyOpts) minSeeds(provider string) int {
+	if minSeeds, found := opts.Providers[provider]; found {
+		return minSeeds
+	}
+	return opts.MinSeeds
+}
+
+// SeedCounts is the number of seeds per provider type and region.
+type SeedCounts map[string]map[string]int
+
+func CountSeeds(seeds []gardener_types.Seed) SeedCounts {
+	out := SeedCounts{}
+	for _, seed := range seeds {
+		provider, region := seed.Spec.Provider.Type, seed.Spec.Provider.Region
+		if out[provider] == nil {
+			out[provider] = map[string]int{}
+		}
+		out[provider][region]++
+	}
+	return out
+}
+
+// RedundancyTransformer removes the regions backed by fewer selected seeds than required
+// and marks the ones backed by a single seed, if requested.
+// The seeds are counted per provider and region name of the mapping, so the regions it merges count the seeds of all of them.
+// It has to run before any transformer renaming the providers or regions.
+func RedundancyTransformer(opts RedundancyOpts, mapping MappingOpts) Transformer {
+	return func(selected []gardener_types.Seed, providers types.Providers) (types.Providers, error) {
+		counts := mapping.mappedCounts(CountSeeds(selected))
+		for provider, info := range providers {
+			minSeeds := opts.minSeeds(provider)
+			for _, region := range slices.Clone(info.SeedRegions) {
+				count := mapping.count(counts, provider, region)
+				if count < minSeeds {
+					slog.Info("region removed, not enough seeds", "provider", provider, "region", region, "seeds", count, "minSeeds", minSeeds)
+					providers.Remove(provider, region)
+					continue
+				}
+
+				if opts.MarkSingleSeed && count == 1 {
+					providers.MarkSingleSeed(provider, region)
+				}
+			}
+		}
+		return providers, nil
+	}
+}
@@ -0,0 +1,87 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedundancyTransformer(t *testing.T) {
+	selected := []gardener_types.Seed{
+		newSeed(withName("seed1")),
+		newSeed(withName("seed2")),
+		newSeed(withName("seed3"), withRegion(testRegion2)),
+		newSeed(withName("seed4"), withProvider(testProviderType2)),
+	}
+
+	testCases := []struct {
+		name     string
+		opts     seeker.RedundancyOpts
+		expected types.Providers
+	}{
+		{
+			name: "global minimum",
+			opts: seeker.RedundancyOpts{MinSeeds: 2},
+			expected: types.Providers{
+				testProviderType1: {SeedRegions: []string{testRegion1}},
+			},
+		},
+		{
+			name: "provider minimum",
+			opts: seeker.RedundancyOpts{MinSeeds: 2, Providers: map[string]int{testProviderType2: 1}},
+			expected: types.Providers{
+				testProviderType1: {SeedRegions: []string{testRegion1}},
+				testProviderType2: {SeedRegions: []string{testRegion1}},
+			},
+		},
+		{
+			name: "single seed marker",
+			opts: seeker.RedundancyOpts{MarkSingleSeed: true},
+			expected: types.Providers{
+				testProviderType1: {SeedRegions: []string{testRegion1, testRegion2}, SingleSeedRegions: []string{testRegion2}},
+				testProviderType2: {SeedRegions: []string{testRegion1}, SingleSeedRegions: []string{testRegion1}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			providers, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+			require.NoError(t, err)
+
+			// WHEN
+			actual, err := seeker.RedundancyTransformer(testCase.opts, seeker.MappingOpts{})(selected, providers)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRedundancyTransformerMergedProviders(t *testing.T) {
+	// GIVEN
+	selected := []gardener_types.Seed{
+		newSeed(withProvider(testProviderType1)),
+		newSeed(withProvider(testProviderType2)),
+	}
+	mapping := seeker.MappingOpts{Providers: map[string]seeker.ProviderMapping{
+		testProviderType1: {Name: "kyma-provider"},
+		testProviderType2: {Name: "kyma-provider"},
+	}}
+	providers, err := seeker.GroupRegionsTransformer(selected, types.Providers{})
+	require.NoError(t, err)
+
+	// WHEN
+	providers, err = seeker.RedundancyTransformer(seeker.RedundancyOpts{MinSeeds: 2, MarkSingleSeed: true}, mapping)(selected, providers)
+	require.NoError(t, err)
+	actual, err := seeker.MappingTransformer(mapping)(selected, providers)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, types.Providers{"kyma-provider": {SeedRegions: []string{testRegion1}}}, actual)
+}
@@ -0,0 +1,157 @@
+package seeker
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// seedRuleCostLimit bounds the evaluation cost of a single rule, so a faulty expression cannot stall the synchronisation.
+const seedRuleCostLimit = 1_000_000
+
+var ErrInvalidSeedRule = errors.New("invalid seed rule")
+
+// SeedRule is a custom seed eligibility rule.
+// The expression is written in CEL, has access to the seed object as the `seed` variable and has to evaluate to a bool,
+// e.g. `!has(seed.metadata.labels) || seed.metadata.labels["example.com/excluded"] != "true"`.
+type SeedRule struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+// CompiledSeedRule is a seed rule ready to be evaluated.
+type CompiledSeedRule struct {
+	SeedRule
+	program cel.Program
+}
+
+func newSeedRuleEnv() (*cel.Env, error) {
+	return cel.NewEnv(cel.Variable("seed", cel.MapType(cel.StringType, cel.DynType)))
+}
+
+// CompileSeedRules compiles the rules and checks that every rule has a unique name and evaluates to a bool.
+func CompileSeedRules(rules []SeedRule) ([]CompiledSeedRule, error) {
+	env, err := newSeedRuleEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+	var out []CompiledSeedRule
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("%w: rule without name", ErrInvalidSeedRule)
+		}
+		if _, found := names[rule.Name]; found {
+			return nil, fmt.Errorf("%w: duplicated rule name %q", ErrInvalidSeedRule, rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		compiled, err := compileSeedRule(env, rule)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, compiled)
+	}
+	return out, nil
+}
+
+// MustCompileSeedRules is CompileSeedRules panicking on an invalid rule, meant for tests.
+func MustCompileSeedRules(rules ...SeedRule) []CompiledSeedRule {
+	out, err := CompileSeedRules(rules)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func compileSeedRule(env *cel.Env, rule SeedRule) (CompiledSeedRule, error) {
+	ast, issues := env.Compile(rule.Expression)
+	if issues != nil && issues.Err() != nil {
+		return CompiledSeedRule{}, fmt.Errorf("%w: rule %q: %w", ErrInvalidSeedRule, rule.Name, issues.Err())
+	}
+
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return CompiledSeedRule{}, fmt.Errorf("%w: rule %q evaluates to %s instead of bool", ErrInvalidSeedRule, rule.Name, ast.OutputType())
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(seedRuleCostLimit))
+	if err != nil {
+		return CompiledSeedRule{}, fmt.Errorf("%w: rule %q: %w", ErrInvalidSeedRule, rule.Name, err)
+	}
+
+	return CompiledSeedRule{SeedRule: rule, program: program}, nil
+}
+
+// Evaluate reports whether the seed satisfies the rule.
+func (rule CompiledSeedRule) Evaluate(seed *gardener_types.Seed) (bool, error) {
+	activation, err := seedRuleActivation(seed)
+	if err != nil {
+		return false, err
+	}
+	return rule.evaluate(activation)
+}
+
+// seedRuleActivation converts the seed to the variables of the rules, once for all rules evaluated against the seed.
+func seedRuleActivation(seed *gardener_types.Seed) (map[string]any, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(seed)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"seed": object}, nil
+}
+
+func (rule CompiledSeedRule) evaluate(activation map[string]any) (bool, error) {
+	value, _, err := rule.program.Eval(activation)
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate rule %q: %w", rule.Name, err)
+	}
+
+	result, ok := value.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %q evaluated to %s instead of bool", rule.Name, value.Type())
+	}
+	return result, nil
+}
+
+// EvaluateSeedRule compiles and evaluates a single rule against the seed.
+// It is meant for testing rules before adding them to the converter config.
+func EvaluateSeedRule(rule SeedRule, seed *gardener_types.Seed) (bool, error) {
+	compiled, err := CompileSeedRules([]SeedRule{rule})
+	if err != nil {
+		return false, err
+	}
+	return compiled[0].Evaluate(seed)
+}
+
+// failedSeedRules returns the names of the rules the seed does not satisfy.
+// A rule that cannot be evaluated, e.g. because of a missing field, is treated as not satisfied.
+func failedSeedRules(seed *gardener_types.Seed, opts SeedOpts) (out []string) {
+	if len(opts.Rules) == 0 {
+		return nil
+	}
+
+	activation, err := seedRuleActivation(seed)
+	if err != nil {
+		slog.Warn("seed rule evaluation failed", "name", seed.Name, "error", err)
+		for _, rule := range opts.Rules {
+			out = append(out, rule.Name)
+		}
+		return out
+	}
+
+	for _, rule := range opts.Rules {
+		ok, err := rule.evaluate(activation)
+		if err != nil {
+			slog.Warn("seed rule evaluation failed", "name", seed.Name, "rule", rule.Name, "error", err)
+		}
+		if !ok {
+			out = append(out, rule.Name)
+		}
+	}
+	return out
+}
@@ -0,0 +1,137 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileSeedRules(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rules       []seeker.SeedRule
+		expectedErr string
+	}{
+		{
+			name: "valid rules",
+			rules: []seeker.SeedRule{
+				{Name: "not-excluded", Expression: `!has(seed.metadata.labels) || seed.metadata.labels["excluded"] != "true"`},
+				{Name: "aws-only", Expression: `seed.spec.provider.type == "aws"`},
+			},
+		},
+		{
+			name:        "syntax error",
+			rules:       []seeker.SeedRule{{Name: "broken", Expression: `seed.spec.provider.type ==`}},
+			expectedErr: `rule "broken"`,
+		},
+		{
+			name:        "not a bool",
+			rules:       []seeker.SeedRule{{Name: "region", Expression: `"region"`}},
+			expectedErr: `rule "region" evaluates to string instead of bool`,
+		},
+		{
+			name:        "missing name",
+			rules:       []seeker.SeedRule{{Expression: `true`}},
+			expectedErr: "rule without name",
+		},
+		{
+			name: "duplicated name",
+			rules: []seeker.SeedRule{
+				{Name: "rule", Expression: `true`},
+				{Name: "rule", Expression: `false`},
+			},
+			expectedErr: `duplicated rule name "rule"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := seeker.CompileSeedRules(testCase.rules)
+
+			// THEN
+			if testCase.expectedErr != "" {
+				require.ErrorIs(t, err, seeker.ErrInvalidSeedRule)
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, actual, len(testCase.rules))
+		})
+	}
+}
+
+func TestEvaluateSeedRule(t *testing.T) {
+	excludedSeed := testSeedOK
+	excludedSeed.Labels = map[string]string{"excluded": "true"}
+
+	testCases := []struct {
+		name        string
+		rule        seeker.SeedRule
+		seed        gardener_types.Seed
+		expected    bool
+		expectedErr bool
+	}{
+		{
+			name:     "satisfied",
+			rule:     seeker.SeedRule{Name: "provider", Expression: `seed.spec.provider.type == "` + testProviderType1 + `"`},
+			seed:     testSeedOK,
+			expected: true,
+		},
+		{
+			name: "not satisfied",
+			rule: seeker.SeedRule{Name: "provider", Expression: `seed.spec.provider.type == "other"`},
+			seed: testSeedOK,
+		},
+		{
+			name:     "label absent",
+			rule:     seeker.SeedRule{Name: "not-excluded", Expression: `!has(seed.metadata.labels) || seed.metadata.labels["excluded"] != "true"`},
+			seed:     testSeedOK,
+			expected: true,
+		},
+		{
+			name: "label present",
+			rule: seeker.SeedRule{Name: "not-excluded", Expression: `!has(seed.metadata.labels) || seed.metadata.labels["excluded"] != "true"`},
+			seed: excludedSeed,
+		},
+		{
+			name:        "missing field",
+			rule:        seeker.SeedRule{Name: "labelled", Expression: `seed.metadata.labels["excluded"] == "true"`},
+			seed:        testSeedOK,
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := seeker.EvaluateSeedRule(testCase.rule, &testCase.seed)
+
+			// THEN
+			if testCase.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestSeedCanBeUsedWithRules(t *testing.T) {
+	// GIVEN
+	opts := seeker.SeedOpts{
+		Rules: seeker.MustCompileSeedRules(
+			seeker.SeedRule{Name: "provider", Expression: `seed.spec.provider.type == "` + testProviderType1 + `"`},
+			seeker.SeedRule{Name: "region", Expression: `seed.spec.provider.region == "other"`},
+		),
+	}
+
+	// WHEN
+	actual := seeker.SeedCanBeUsed(&testSeedOK, opts)
+
+	// THEN
+	require.False(t, actual)
+}
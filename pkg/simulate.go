@@ -0,0 +1,119 @@
+package seeker
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+)
+
+// DefaultSimulationHAMinZones is the number of zones Gardener requires from a seed for control planes tolerating a zone failure.
+const DefaultSimulationHAMinZones = 3
+
+// ShootPlacement is the shoot-like input of the placement simulation.
+type ShootPlacement struct {
+	Provider string `json:"provider"`
+	Region   string `json:"region"`
+	// Tolerations of the shoot, they replace the configured tolerations.
+	Tolerations []gardener_types.Toleration `json:"tolerations,omitempty"`
+	// AccessRestrictions the seed has to support.
+	AccessRestrictions []string `json:"accessRestrictions,omitempty"`
+	// HighAvailability requires a seed spanning at least SimulationOpts.HAMinZones zones.
+	HighAvailability bool `json:"highAvailability,omitempty"`
+}
+
+type SimulationOpts struct {
+	SeedOpts
+	// HAMinZones defaults to DefaultSimulationHAMinZones.
+	HAMinZones int
+}
+
+func (opts SimulationOpts) haMinZones() int {
+	if opts.HAMinZones <= 0 {
+		return DefaultSimulationHAMinZones
+	}
+	return opts.HAMinZones
+}
+
+// CandidateSeed is a seed the shoot could be scheduled on.
+type CandidateSeed struct {
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+// ExcludedSeed is a seed the shoot cannot be scheduled on, with the reasons.
+type ExcludedSeed struct {
+	Name    string   `json:"name"`
+	Region  string   `json:"region"`
+	Reasons []string `json:"reasons"`
+}
+
+type SimulationResult struct {
+	// Candidates are sorted with the seeds of the shoot region first, then by region and name.
+	Candidates []CandidateSeed `json:"candidates"`
+	// SameRegion reports whether a candidate is in the shoot region, otherwise Gardener picks a seed of another region.
+	SameRegion bool           `json:"sameRegion"`
+	Excluded   []ExcludedSeed `json:"excluded,omitempty"`
+}
+
+// SimulatePlacement lists the seeds the shoot could be scheduled on, using the checks of SeedCanBeUsed
+// and the provider type, access restrictions, and zones the shoot requires, and why each other seed is excluded.
+func SimulatePlacement(seeds []gardener_types.Seed, shoot ShootPlacement, opts SimulationOpts) SimulationResult {
+	out := SimulationResult{Candidates: []CandidateSeed{}}
+	for _, seed := range seeds {
+		reasons := placementReasons(&seed, shoot, opts)
+		region := seed.Spec.Provider.Region
+		if len(reasons) > 0 {
+			out.Excluded = append(out.Excluded, ExcludedSeed{Name: seed.Name, Region: region, Reasons: reasons})
+			continue
+		}
+
+		out.Candidates = append(out.Candidates, CandidateSeed{Name: seed.Name, Region: region})
+		out.SameRegion = out.SameRegion || region == shoot.Region
+	}
+
+	slices.SortFunc(out.Candidates, func(a, b CandidateSeed) int {
+		// false sorts before true, so the seeds of the shoot region come first
+		return cmp.Or(
+			cmp.Compare(boolOrder(a.Region != shoot.Region), boolOrder(b.Region != shoot.Region)),
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	slices.SortFunc(out.Excluded, func(a, b ExcludedSeed) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+func placementReasons(seed *gardener_types.Seed, shoot ShootPlacement, opts SimulationOpts) (out []string) {
+	if seed.Spec.Provider.Type != shoot.Provider {
+		out = append(out, fmt.Sprintf("provider type %s does not match", seed.Spec.Provider.Type))
+	}
+	for _, restriction := range shoot.AccessRestrictions {
+		if !slices.ContainsFunc(seed.Spec.AccessRestrictions, func(supported gardener_types.AccessRestriction) bool {
+			return supported.Name == restriction
+		}) {
+			out = append(out, fmt.Sprintf("access restriction %s not supported", restriction))
+		}
+	}
+	if shoot.HighAvailability && len(seed.Spec.Provider.Zones) < opts.haMinZones() {
+		out = append(out, fmt.Sprintf("seed spans %d zones, highly-available control planes require %d", len(seed.Spec.Provider.Zones), opts.haMinZones()))
+	}
+
+	seedOpts := opts.SeedOpts
+	seedOpts.Tolerations = nil
+	seedOpts.ProviderTolerations = config.TolerationsConfig{
+		TolerationsKey(seed.Spec.Provider.Type, seed.Spec.Provider.Region): shoot.Tolerations,
+	}
+	return append(out, evaluateSeed(seed, seedOpts).reasons(seed, seedOpts)...)
+}
+
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,84 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatePlacement(t *testing.T) {
+	seeds := []gardener_types.Seed{
+		newSeed(withName("other-region"), withRegion(testRegion2)),
+		newSeed(withName("same-region"), withZones("a", "b", "c"), withAccessRestrictions("eu-access-only")),
+		newSeed(withName("tainted"), withTaints(gardener_types.SeedTaint{Key: testTaintKey1})),
+		newSeed(withName("other-provider"), withProvider(testProviderType2)),
+		newSeed(withName("not-ready"), func(seed *gardener_types.Seed) {
+			seed.Status.LastOperation = nil
+		}),
+	}
+
+	testCases := []struct {
+		name     string
+		shoot    seeker.ShootPlacement
+		expected seeker.SimulationResult
+	}{
+		{
+			name:  "same region",
+			shoot: seeker.ShootPlacement{Provider: testProviderType1, Region: testRegion1},
+			expected: seeker.SimulationResult{
+				Candidates: []seeker.CandidateSeed{{Name: "same-region", Region: testRegion1}, {Name: "other-region", Region: testRegion2}},
+				SameRegion: true,
+				Excluded: []seeker.ExcludedSeed{
+					{Name: "not-ready", Region: testRegion1, Reasons: []string{"seed has no last operation"}},
+					{Name: "other-provider", Region: testRegion1, Reasons: []string{"provider type test-provider-type2 does not match"}},
+					{Name: "tainted", Region: testRegion1, Reasons: []string{"seed taints are not tolerated"}},
+				},
+			},
+		},
+		{
+			name: "tolerations, access restrictions and high availability",
+			shoot: seeker.ShootPlacement{
+				Provider:           testProviderType1,
+				Region:             testRegion2,
+				Tolerations:        []gardener_types.Toleration{{Key: testTaintKey1}},
+				AccessRestrictions: []string{"eu-access-only"},
+				HighAvailability:   true,
+			},
+			expected: seeker.SimulationResult{
+				Candidates: []seeker.CandidateSeed{{Name: "same-region", Region: testRegion1}},
+				Excluded: []seeker.ExcludedSeed{
+					{Name: "not-ready", Region: testRegion1, Reasons: []string{
+						"access restriction eu-access-only not supported",
+						"seed spans 0 zones, highly-available control planes require 3",
+						"seed has no last operation",
+					}},
+					{Name: "other-provider", Region: testRegion1, Reasons: []string{
+						"provider type test-provider-type2 does not match",
+						"access restriction eu-access-only not supported",
+						"seed spans 0 zones, highly-available control planes require 3",
+					}},
+					{Name: "other-region", Region: testRegion2, Reasons: []string{
+						"access restriction eu-access-only not supported",
+						"seed spans 0 zones, highly-available control planes require 3",
+					}},
+					{Name: "tainted", Region: testRegion1, Reasons: []string{
+						"access restriction eu-access-only not supported",
+						"seed spans 0 zones, highly-available control planes require 3",
+					}},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.SimulatePlacement(seeds, testCase.shoot, seeker.SimulationOpts{})
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
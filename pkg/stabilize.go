@@ -0,0 +1,189 @@
+package seeker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const seedStatesKey = "seeds"
+
+// Stabilize selects the seeds to publish out of all fetched seeds and the currently usable ones.
+type Stabilize func(ctx context.Context, seeds, usable []gardener_types.Seed) ([]gardener_types.Seed, error)
+
+// SeedState is the eligibility of a seed remembered across synchronisations.
+type SeedState struct {
+	// Usable is the result of the latest eligibility check.
+	Usable bool `json:"usable"`
+	// Since is the time the latest eligibility check result was first observed.
+	Since time.Time `json:"since"`
+	// UnusableRuns is the number of consecutive synchronisations the seed was not usable.
+	UnusableRuns int `json:"unusableRuns,omitempty"`
+	// Published is true if the seed contributes to the published regions.
+	Published bool `json:"published"`
+}
+
+type SeedStates map[string]SeedState
+
+type StabilizeOpts struct {
+	// RemoveAfterRuns is the number of consecutive synchronisations a published seed has to be unusable before it is removed.
+	RemoveAfterRuns int
+	// RemoveAfter is the duration a published seed has to be unusable before it is removed.
+	RemoveAfter time.Duration
+	// AddAfter is the duration a new seed has to be usable before it is published.
+	AddAfter time.Duration
+	Now      func() time.Time
+	Timeout  time.Duration
+	Key      client.ObjectKey
+	Patch
+	Get
+}
+
+// NextSeedStates computes the seed states of the current synchronisation.
+// A published seed stays published until it has been unusable for RemoveAfterRuns synchronisations or for RemoveAfter,
+// whichever is reached first; an unpublished seed is published once it has been usable for AddAfter.
+// Without previous states, all usable seeds are published right away.
+func (opts StabilizeOpts) NextSeedStates(previous SeedStates, seeds, usable []gardener_types.Seed, now time.Time) SeedStates {
+	return opts.nextSeedStates(previous, seeds, usable, now, true)
+}
+
+// nextSeedStates computes the seed states, countRun is false for a resynchronisation, which is not counted in UnusableRuns.
+func (opts StabilizeOpts) nextSeedStates(previous SeedStates, seeds, usable []gardener_types.Seed, now time.Time, countRun bool) SeedStates {
+	isUsable := make(map[string]bool, len(usable))
+	for _, seed := range usable {
+		isUsable[seed.Name] = true
+	}
+
+	out := make(SeedStates, len(seeds))
+	for _, seed := range seeds {
+		current := SeedState{
+			Usable: isUsable[seed.Name],
+			Since:  now,
+		}
+
+		state, found := previous[seed.Name]
+		switch {
+		case len(previous) == 0:
+			current.Published = current.Usable
+
+		case !found:
+			current.Published = current.Usable && opts.AddAfter == 0
+
+		default:
+			if state.Usable == current.Usable {
+				current.Since = state.Since
+			}
+
+			if current.Usable {
+				current.Published = state.Published || now.Sub(current.Since) >= opts.AddAfter
+				break
+			}
+
+			current.UnusableRuns = state.UnusableRuns
+			if countRun {
+				current.UnusableRuns++
+			}
+			current.Published = state.Published && !opts.removalDue(current, now)
+		}
+
+		out[seed.Name] = current
+	}
+
+	return out
+}
+
+func (opts StabilizeOpts) removalDue(state SeedState, now time.Time) bool {
+	if opts.RemoveAfterRuns <= 0 && opts.RemoveAfter <= 0 {
+		return true
+	}
+
+	removeByRuns := opts.RemoveAfterRuns > 0 && state.UnusableRuns >= opts.RemoveAfterRuns
+	removeByTime := opts.RemoveAfter > 0 && now.Sub(state.Since) >= opts.RemoveAfter
+	return removeByRuns || removeByTime
+}
+
+// stabilizeScopeKey is the synchronisation scope key of the seed states to persist in the config map.
+type stabilizeScopeKey struct {
+	key client.ObjectKey
+}
+
+// BuildStabilizeFn selects the seeds to publish based on the seed states remembered in a config map between synchronisations,
+// so a seed flapping for a short time does not add or remove its region.
+// The new states are persisted by the StabilizeSink only after the result has been published.
+func BuildStabilizeFn(opts StabilizeOpts) Stabilize {
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return func(ctx context.Context, seeds, usable []gardener_types.Seed) (out []gardener_types.Seed, err error) {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+		defer LogWithDuration(time.Now(), "seed stabilisation complete", "key", opts.Key)
+
+		var cm corev1.ConfigMap
+		if err = opts.Get(ctx, opts.Key, &cm); err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		var previous SeedStates
+		if err = yaml.Unmarshal([]byte(cm.Data[seedStatesKey]), &previous); err != nil {
+			return nil, err
+		}
+
+		states := opts.nextSeedStates(previous, seeds, usable, now(), !isResync(ctx))
+		for _, seed := range seeds {
+			state := states[seed.Name]
+			if state.Published {
+				out = append(out, seed)
+			}
+
+			if state.Published != state.Usable {
+				slog.Info("seed eligibility change postponed",
+					"name", seed.Name,
+					"usable", state.Usable,
+					"since", state.Since,
+					"unusableRuns", state.UnusableRuns)
+			}
+		}
+
+		setScopeValue(ctx, stabilizeScopeKey{opts.Key}, states)
+		return out, nil
+	}
+}
+
+// StabilizeSink persists the seed states computed by the stabilize filter of the same synchronisation.
+// It has to be the last sink, so the states are not advanced if publishing the result fails.
+func StabilizeSink(opts StabilizeOpts) Sink {
+	return func(ctx context.Context, _ []gardener_types.Seed, _ types.Providers) error {
+		states, found := scopeValue[SeedStates](ctx, stabilizeScopeKey{opts.Key})
+		if !found {
+			slog.Warn("no seed states to persist, the stabilize filter did not run", "key", opts.Key)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+		defer LogWithDuration(time.Now(), "storing seed states complete", "key", opts.Key)
+
+		data, err := yaml.Marshal(states)
+		if err != nil {
+			return err
+		}
+
+		cm := corev1.ConfigMap{Data: map[string]string{seedStatesKey: string(data)}}
+		cm.Name = opts.Key.Name
+		cm.Namespace = opts.Key.Namespace
+		cm.TypeMeta.Kind = "ConfigMap"
+		cm.TypeMeta.APIVersion = "v1"
+
+		return applyConfigMap(ctx, opts.Patch, &cm)
+	}
+}
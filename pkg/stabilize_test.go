@@ -0,0 +1,198 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	testNow      = time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	testSeedName = "test-seed"
+	testSeed     = newSeed(withName(testSeedName))
+)
+
+func TestNextSeedStates(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     seeker.StabilizeOpts
+		previous seeker.SeedStates
+		usable   []gardener_types.Seed
+		expected seeker.SeedState
+	}{
+		{
+			name:     "first run publishes usable seed",
+			opts:     seeker.StabilizeOpts{AddAfter: time.Hour},
+			usable:   []gardener_types.Seed{testSeed},
+			expected: seeker.SeedState{Usable: true, Since: testNow, Published: true},
+		},
+		{
+			name:     "new seed waits for addition delay",
+			opts:     seeker.StabilizeOpts{AddAfter: time.Hour},
+			previous: seeker.SeedStates{"other": {}},
+			usable:   []gardener_types.Seed{testSeed},
+			expected: seeker.SeedState{Usable: true, Since: testNow},
+		},
+		{
+			name: "seed published after addition delay",
+			opts: seeker.StabilizeOpts{AddAfter: time.Hour},
+			previous: seeker.SeedStates{
+				testSeedName: {Usable: true, Since: testNow.Add(-time.Hour)},
+			},
+			usable:   []gardener_types.Seed{testSeed},
+			expected: seeker.SeedState{Usable: true, Since: testNow.Add(-time.Hour), Published: true},
+		},
+		{
+			name: "seed removed immediately without removal thresholds",
+			previous: seeker.SeedStates{
+				testSeedName: {Usable: true, Since: testNow.Add(-time.Hour), Published: true},
+			},
+			expected: seeker.SeedState{Since: testNow, UnusableRuns: 1},
+		},
+		{
+			name: "seed kept until removal runs reached",
+			opts: seeker.StabilizeOpts{RemoveAfterRuns: 3},
+			previous: seeker.SeedStates{
+				testSeedName: {Since: testNow.Add(-time.Hour), UnusableRuns: 1, Published: true},
+			},
+			expected: seeker.SeedState{Since: testNow.Add(-time.Hour), UnusableRuns: 2, Published: true},
+		},
+		{
+			name: "seed removed after removal runs",
+			opts: seeker.StabilizeOpts{RemoveAfterRuns: 3, RemoveAfter: 24 * time.Hour},
+			previous: seeker.SeedStates{
+				testSeedName: {Since: testNow.Add(-time.Hour), UnusableRuns: 2, Published: true},
+			},
+			expected: seeker.SeedState{Since: testNow.Add(-time.Hour), UnusableRuns: 3},
+		},
+		{
+			name: "seed removed after removal delay",
+			opts: seeker.StabilizeOpts{RemoveAfterRuns: 3, RemoveAfter: time.Hour},
+			previous: seeker.SeedStates{
+				testSeedName: {Since: testNow.Add(-time.Hour), UnusableRuns: 1, Published: true},
+			},
+			expected: seeker.SeedState{Since: testNow.Add(-time.Hour), UnusableRuns: 2},
+		},
+		{
+			name: "flapping seed stays published",
+			opts: seeker.StabilizeOpts{RemoveAfterRuns: 3},
+			previous: seeker.SeedStates{
+				testSeedName: {Since: testNow.Add(-time.Minute), UnusableRuns: 1, Published: true},
+			},
+			usable:   []gardener_types.Seed{testSeed},
+			expected: seeker.SeedState{Usable: true, Since: testNow, Published: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := testCase.opts.NextSeedStates(testCase.previous, []gardener_types.Seed{testSeed}, testCase.usable, testNow)
+
+			// THEN
+			require.Equal(t, seeker.SeedStates{testSeedName: testCase.expected}, actual)
+		})
+	}
+}
+
+func TestBuildStabilizeFn(t *testing.T) {
+	// GIVEN
+	previous, err := yaml.Marshal(seeker.SeedStates{
+		"flapping": {Usable: true, Since: testNow.Add(-time.Hour), Published: true},
+		"new":      {Usable: true, Since: testNow.Add(-time.Minute)},
+		"deleted":  {Usable: true, Since: testNow.Add(-time.Hour), Published: true},
+	})
+	require.NoError(t, err)
+
+	var stored corev1.ConfigMap
+	opts := seeker.StabilizeOpts{
+		RemoveAfterRuns: 2,
+		AddAfter:        time.Hour,
+		Now:             func() time.Time { return testNow },
+		Key:             client.ObjectKey{Name: testName, Namespace: testNamespace},
+		Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			obj.(*corev1.ConfigMap).Data = map[string]string{"seeds": string(previous)}
+			return nil
+		},
+		Patch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			stored = *obj.(*corev1.ConfigMap)
+			return nil
+		},
+	}
+	stabilize := seeker.BuildStabilizeFn(opts)
+
+	flapping, added := newSeed(withName("flapping")), newSeed(withName("new"))
+	ctx := seeker.WithSyncScope(context.Background())
+
+	// WHEN
+	actual, err := stabilize(ctx, []gardener_types.Seed{flapping, added}, []gardener_types.Seed{added})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []gardener_types.Seed{flapping}, actual)
+	require.Empty(t, stored.Data)
+
+	// WHEN
+	err = seeker.StabilizeSink(opts)(ctx, actual, nil)
+
+	// THEN
+	require.NoError(t, err)
+
+	// THEN
+	var states seeker.SeedStates
+	require.NoError(t, yaml.Unmarshal([]byte(stored.Data["seeds"]), &states))
+	require.Equal(t, seeker.SeedStates{
+		"flapping": {Since: testNow, UnusableRuns: 1, Published: true},
+		"new":      {Usable: true, Since: testNow.Add(-time.Minute)},
+	}, states)
+	require.Equal(t, testName, stored.Name)
+	require.Equal(t, testNamespace, stored.Namespace)
+}
+
+func TestBuildStabilizeFnResync(t *testing.T) {
+	// GIVEN
+	previous, err := yaml.Marshal(seeker.SeedStates{
+		"flapping": {Since: testNow.Add(-time.Hour), UnusableRuns: 1, Published: true},
+	})
+	require.NoError(t, err)
+
+	var stored corev1.ConfigMap
+	opts := seeker.StabilizeOpts{
+		RemoveAfterRuns: 2,
+		Now:             func() time.Time { return testNow },
+		Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			obj.(*corev1.ConfigMap).Data = map[string]string{"seeds": string(previous)}
+			return nil
+		},
+		Patch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			stored = *obj.(*corev1.ConfigMap)
+			return nil
+		},
+	}
+
+	flapping := newSeed(withName("flapping"))
+	ctx := seeker.WithResync(seeker.WithSyncScope(context.Background()))
+
+	// WHEN
+	actual, err := seeker.BuildStabilizeFn(opts)(ctx, []gardener_types.Seed{flapping}, nil)
+	require.NoError(t, err)
+	err = seeker.StabilizeSink(opts)(ctx, actual, nil)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []gardener_types.Seed{flapping}, actual)
+
+	// THEN
+	var states seeker.SeedStates
+	require.NoError(t, yaml.Unmarshal([]byte(stored.Data["seeds"]), &states))
+	require.Equal(t, seeker.SeedStates{
+		"flapping": {Since: testNow.Add(-time.Hour), UnusableRuns: 1, Published: true},
+	}, states)
+}
@@ -18,7 +18,7 @@ type Patch func(context.Context, client.Object, client.Patch, ...client.PatchOpt
 
 type Get func(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error
 
-type Store func(types.Providers) error
+type Store func(context.Context, types.Providers) error
 
 type StoreOpts struct {
 	Timeout time.Duration
@@ -34,8 +34,8 @@ func LogWithDuration(startTime time.Time, msg string, args ...any) {
 }
 
 func BuildStoreFn(opts StoreOpts) Store {
-	return func(data types.Providers) (err error) {
-		ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	return func(ctx context.Context, data types.Providers) (err error) {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
 		defer cancel()
 		defer LogWithDuration(time.Now(), "storing data complete", "key", opts.Key)
 
@@ -59,11 +59,15 @@ func BuildStoreFn(opts StoreOpts) Store {
 			return err
 		}
 
-		force := true
-
-		return opts.Patch(ctx, &cm, client.Apply, &client.PatchOptions{
-			FieldManager: FieldManagerName,
-			Force:        &force,
-		})
+		return applyConfigMap(ctx, opts.Patch, &cm)
 	}
 }
+
+func applyConfigMap(ctx context.Context, patch Patch, cm *corev1.ConfigMap) error {
+	force := true
+
+	return patch(ctx, cm, client.Apply, &client.PatchOptions{
+		FieldManager: FieldManagerName,
+		Force:        &force,
+	})
+}
@@ -157,7 +157,7 @@ func TestBuildStoreFn(t *testing.T) {
 			})
 
 			// WHEN
-			err := store(testCase.data2Store)
+			err := store(context.Background(), testCase.data2Store)
 
 			// THEN
 			if testCase.expectedErr == nil {
@@ -1,24 +1,60 @@
 package seeker
 
 import (
+	"context"
 	"time"
 )
 
-type Sync func() error
+// Sync runs a synchronisation, see Registry.Build.
+type Sync func(ctx context.Context) error
 
+// BuildSyncFn stores the fetched providers, like the default pipeline without the optional stages.
 func BuildSyncFn(store Store, fetch FetchSeeds) Sync {
-	return func() (err error) {
+	return func(ctx context.Context) (err error) {
 		defer LogWithDuration(time.Now(), "synchronisation complete")
 
-		providerRegions, err := fetch()
+		providerRegions, err := fetch(ctx)
 		if err != nil {
 			return err
 		}
 
-		if err := store(providerRegions); err != nil {
-			return err
-		}
+		return StoreSink(store)(ctx, nil, providerRegions)
+	}
+}
 
-		return nil
+type syncScopeKey struct{}
+
+type resyncKey struct{}
+
+// syncScope holds the values the stages of one synchronisation share, e.g. a filter with the sink persisting its result.
+type syncScope map[any]any
+
+// WithSyncScope returns a context carrying a new synchronisation scope.
+// The synchronisations built by Registry.Build run in their own scope, stages called directly need one to share values.
+func WithSyncScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, syncScopeKey{}, syncScope{})
+}
+
+// WithResync marks the synchronisation as an additional one, e.g. triggered by a configuration change,
+// which is not counted as a synchronisation run by the stages.
+func WithResync(ctx context.Context) context.Context {
+	return context.WithValue(ctx, resyncKey{}, true)
+}
+
+func isResync(ctx context.Context) bool {
+	resync, _ := ctx.Value(resyncKey{}).(bool)
+	return resync
+}
+
+// setScopeValue stores the value in the synchronisation scope of the context, if any.
+func setScopeValue(ctx context.Context, key, value any) {
+	if scope, found := ctx.Value(syncScopeKey{}).(syncScope); found {
+		scope[key] = value
 	}
 }
+
+func scopeValue[T any](ctx context.Context, key any) (T, bool) {
+	scope, _ := ctx.Value(syncScopeKey{}).(syncScope)
+	value, found := scope[key].(T)
+	return value, found
+}
@@ -1,9 +1,11 @@
 package seeker_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 	"github.com/stretchr/testify/require"
@@ -45,7 +47,7 @@ func TestBuildSyncFn(t *testing.T) {
 			sync := seeker.BuildSyncFn(testCase.store, testCase.fetch)
 
 			// WHEN
-			err := sync()
+			err := sync(context.Background())
 
 			// THEN
 			if testCase.expectedErr == nil {
@@ -60,26 +62,126 @@ func TestBuildSyncFn(t *testing.T) {
 	}
 }
 
+func TestSync(t *testing.T) {
+	testCases := []struct {
+		name        string
+		source      seeker.Source
+		filter      seeker.Filter
+		sink        seeker.Sink
+		expectedErr error
+	}{
+		{
+			name:        "fetch error",
+			source:      buildSourceWithError(errFetchSeedsFailedTest),
+			sink:        buildSink(),
+			expectedErr: errFetchSeedsFailedTest,
+		},
+		{
+			name:        "filter error",
+			source:      buildSource(),
+			filter:      buildFilterWithError(errFetchSeedsFailedTest),
+			sink:        buildSink(),
+			expectedErr: errFetchSeedsFailedTest,
+		},
+		{
+			name:        "store error",
+			source:      buildSource(),
+			sink:        buildSinkWithError(errStoreFailedTest),
+			expectedErr: errStoreFailedTest,
+		},
+		{
+			name:   "OK",
+			source: buildSource(),
+			sink:   buildSink(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			pipeline := seeker.PipelineConfig{
+				Sources:      []string{seeker.StageGardener},
+				Transformers: []string{seeker.StageGroupRegions},
+				Sinks:        []string{seeker.StageConfigMap},
+			}
+			registry := seeker.NewRegistry()
+			require.NoError(t, registry.RegisterSource(seeker.StageGardener, testCase.source))
+			require.NoError(t, registry.RegisterTransformer(seeker.StageGroupRegions, seeker.GroupRegionsTransformer))
+			require.NoError(t, registry.RegisterSink(seeker.StageConfigMap, testCase.sink))
+			if testCase.filter != nil {
+				pipeline.Filters = []string{seeker.StageEligibility}
+				require.NoError(t, registry.RegisterFilter(seeker.StageEligibility, testCase.filter))
+			}
+
+			sync, err := registry.Build(pipeline)
+			require.NoError(t, err)
+
+			// WHEN
+			err = sync(context.Background())
+
+			// THEN
+			if testCase.expectedErr == nil {
+				require.NoError(t, err)
+			}
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.EqualError(t, err, testCase.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func buildSourceWithError(err error) seeker.Source {
+	return func(context.Context) ([]gardener_types.Seed, error) {
+		return nil, err
+	}
+}
+
+func buildSource() seeker.Source {
+	return func(context.Context) ([]gardener_types.Seed, error) {
+		return []gardener_types.Seed{testSeedOK}, nil
+	}
+}
+
+func buildFilterWithError(err error) seeker.Filter {
+	return func(context.Context, []gardener_types.Seed, []gardener_types.Seed) ([]gardener_types.Seed, error) {
+		return nil, err
+	}
+}
+
+func buildSinkWithError(err error) seeker.Sink {
+	return func(context.Context, []gardener_types.Seed, types.Providers) error {
+		return err
+	}
+}
+
+func buildSink() seeker.Sink {
+	return func(context.Context, []gardener_types.Seed, types.Providers) error {
+		return nil
+	}
+}
+
 func buildFetchSeedsWithError(err error) seeker.FetchSeeds {
-	return func() (types.Providers, error) {
+	return func(context.Context) (types.Providers, error) {
 		return nil, err
 	}
 }
 
 func buildFetch(out types.Providers) seeker.FetchSeeds {
-	return func() (types.Providers, error) {
+	return func(context.Context) (types.Providers, error) {
 		return out, nil
 	}
 }
 
 func buildStoreWithError(err error) seeker.Store {
-	return func(regions types.Providers) error {
+	return func(_ context.Context, regions types.Providers) error {
 		return err
 	}
 }
 
 func buildStore() seeker.Store {
-	return func(pr types.Providers) error {
+	return func(_ context.Context, pr types.Providers) error {
 		return nil
 	}
 }
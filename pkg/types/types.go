@@ -7,6 +7,16 @@ import (
 
 type ProviderInfo struct {
 	SeedRegions []string `json:"seedRegions"`
+	// SingleSeedRegions lists the seed regions backed by a single seed, if requested.
+	SingleSeedRegions []string `json:"singleSeedRegions,omitempty"`
+	// AccessRestrictions lists the seed regions per access restriction supported by at least one seed in the region.
+	AccessRestrictions map[string][]string `json:"accessRestrictions,omitempty"`
+	// HARegions lists the seed regions with at least one multi-zonal seed, if requested.
+	HARegions []string `json:"haRegions,omitempty"`
+	// IPFamilies lists the IP family stacks of the seeds per region, e.g. `IPv4` or `IPv4,IPv6` for dual-stack seeds.
+	IPFamilies map[string][]string `json:"ipFamilies,omitempty"`
+	// Labels lists the distinct values of the published seed labels per region and label key, if requested.
+	Labels map[string]map[string][]string `json:"labels,omitempty"`
 }
 
 type Providers map[string]ProviderInfo
@@ -21,3 +31,125 @@ func (s *Providers) Add(provider, regionName string) {
 	providerInfo.SeedRegions = append(providerInfo.SeedRegions, regionName)
 	(*s)[provider] = providerInfo
 }
+
+// Remove removes the region from the provider, and the provider once it has no regions left.
+func (s *Providers) Remove(provider, regionName string) {
+	providerInfo, found := (*s)[provider]
+	if !found {
+		return
+	}
+
+	providerInfo.SeedRegions = slices.DeleteFunc(providerInfo.SeedRegions, func(region string) bool { return region == regionName })
+	providerInfo.SingleSeedRegions = slices.DeleteFunc(providerInfo.SingleSeedRegions, func(region string) bool { return region == regionName })
+	providerInfo.HARegions = slices.DeleteFunc(providerInfo.HARegions, func(region string) bool { return region == regionName })
+	delete(providerInfo.IPFamilies, regionName)
+	delete(providerInfo.Labels, regionName)
+	for restriction, regions := range providerInfo.AccessRestrictions {
+		regions = slices.DeleteFunc(regions, func(region string) bool { return region == regionName })
+		if len(regions) == 0 {
+			delete(providerInfo.AccessRestrictions, restriction)
+			continue
+		}
+		providerInfo.AccessRestrictions[restriction] = regions
+	}
+	if len(providerInfo.SeedRegions) == 0 {
+		delete(*s, provider)
+		return
+	}
+	(*s)[provider] = providerInfo
+}
+
+// MarkSingleSeed marks the region of the provider as backed by a single seed.
+func (s *Providers) MarkSingleSeed(provider, regionName string) {
+	providerInfo := (*s)[provider]
+	if slices.Contains(providerInfo.SingleSeedRegions, regionName) {
+		return
+	}
+
+	providerInfo.SingleSeedRegions = append(providerInfo.SingleSeedRegions, regionName)
+	(*s)[provider] = providerInfo
+}
+
+// MarkHA marks the region of the provider as capable of highly-available control planes.
+func (s *Providers) MarkHA(provider, regionName string) {
+	providerInfo := (*s)[provider]
+	if slices.Contains(providerInfo.HARegions, regionName) {
+		return
+	}
+
+	providerInfo.HARegions = append(providerInfo.HARegions, regionName)
+	(*s)[provider] = providerInfo
+}
+
+// AddIPFamilies adds the IP family stack to the stacks supported in the region of the provider.
+func (s *Providers) AddIPFamilies(provider, regionName, stack string) {
+	providerInfo := (*s)[provider]
+	if slices.Contains(providerInfo.IPFamilies[regionName], stack) {
+		return
+	}
+
+	if providerInfo.IPFamilies == nil {
+		providerInfo.IPFamilies = map[string][]string{}
+	}
+	providerInfo.IPFamilies[regionName] = append(providerInfo.IPFamilies[regionName], stack)
+	slices.Sort(providerInfo.IPFamilies[regionName])
+	(*s)[provider] = providerInfo
+}
+
+// AddLabel adds the value of the seed label to the values of the label in the region of the provider.
+func (s *Providers) AddLabel(provider, regionName, key, value string) {
+	providerInfo := (*s)[provider]
+	if slices.Contains(providerInfo.Labels[regionName][key], value) {
+		return
+	}
+
+	if providerInfo.Labels == nil {
+		providerInfo.Labels = map[string]map[string][]string{}
+	}
+	if providerInfo.Labels[regionName] == nil {
+		providerInfo.Labels[regionName] = map[string][]string{}
+	}
+	providerInfo.Labels[regionName][key] = append(providerInfo.Labels[regionName][key], value)
+	slices.Sort(providerInfo.Labels[regionName][key])
+	(*s)[provider] = providerInfo
+}
+
+// AddAccessRestriction adds the region to the regions of the provider supporting the access restriction.
+func (s *Providers) AddAccessRestriction(provider, restriction, regionName string) {
+	providerInfo := (*s)[provider]
+	if slices.Contains(providerInfo.AccessRestrictions[restriction], regionName) {
+		return
+	}
+
+	if providerInfo.AccessRestrictions == nil {
+		providerInfo.AccessRestrictions = map[string][]string{}
+	}
+	providerInfo.AccessRestrictions[restriction] = append(providerInfo.AccessRestrictions[restriction], regionName)
+	(*s)[provider] = providerInfo
+}
+
+// CatalogRegion is a region offered to shoots by the cloud profiles, with the availability of seeds in it.
+type CatalogRegion struct {
+	Name      string            `json:"name"`
+	HasSeed   bool              `json:"hasSeed"`
+	SeedCount int               `json:"seedCount"`
+	Zones     []string          `json:"zones,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// RegionCatalog lists the regions offered to shoots per provider.
+type RegionCatalog map[string][]CatalogRegion
+
+// SeedNetwork lists the network ranges of a seed, shoot networks must not overlap with them.
+type SeedNetwork struct {
+	Name                 string `json:"name"`
+	Region               string `json:"region"`
+	Nodes                string `json:"nodes,omitempty"`
+	Pods                 string `json:"pods"`
+	Services             string `json:"services"`
+	ShootDefaultPods     string `json:"shootDefaultPods,omitempty"`
+	ShootDefaultServices string `json:"shootDefaultServices,omitempty"`
+}
+
+// SeedNetworks lists the networks of the seeds per provider.
+type SeedNetworks map[string][]SeedNetwork
@@ -71,3 +71,64 @@ func TestProviderRegionSeed_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestProviders_Remove(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{
+		testProviderName: {
+			SeedRegions:       []string{testRegionName, "some-other-test-region"},
+			SingleSeedRegions: []string{testRegionName},
+		},
+	}
+
+	// WHEN
+	providers.Remove(testProviderName, testRegionName)
+
+	// THEN
+	require.Equal(t, types.Providers{
+		testProviderName: {SeedRegions: []string{"some-other-test-region"}, SingleSeedRegions: []string{}},
+	}, providers)
+
+	// WHEN
+	providers.Remove(testProviderName, "some-other-test-region")
+
+	// THEN
+	require.Empty(t, providers)
+}
+
+func TestProviders_RemoveAccessRestriction(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{}
+	providers.Add(testProviderName, testRegionName)
+	providers.Add(testProviderName, "some-other-test-region")
+	providers.AddAccessRestriction(testProviderName, "eu-access-only", testRegionName)
+	providers.AddAccessRestriction(testProviderName, "eu-access-only", testRegionName)
+	providers.AddAccessRestriction(testProviderName, "other-restriction", "some-other-test-region")
+
+	// WHEN
+	providers.Remove(testProviderName, testRegionName)
+
+	// THEN
+	require.Equal(t, map[string][]string{
+		"other-restriction": {"some-other-test-region"},
+	}, providers[testProviderName].AccessRestrictions)
+}
+
+func TestProviders_RemoveLabels(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{}
+	providers.Add(testProviderName, testRegionName)
+	providers.Add(testProviderName, "some-other-test-region")
+	providers.AddLabel(testProviderName, testRegionName, "environment", "prod")
+	providers.AddLabel(testProviderName, "some-other-test-region", "environment", "prod")
+	providers.AddLabel(testProviderName, "some-other-test-region", "environment", "canary")
+	providers.AddLabel(testProviderName, "some-other-test-region", "environment", "prod")
+
+	// WHEN
+	providers.Remove(testProviderName, testRegionName)
+
+	// THEN
+	require.Equal(t, map[string]map[string][]string{
+		"some-other-test-region": {"environment": {"canary", "prod"}},
+	}, providers[testProviderName].Labels)
+}
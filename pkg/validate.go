@@ -0,0 +1,46 @@
+package seeker
+
+import (
+	"fmt"
+	"slices"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ValidateTolerations reports tolerations that cannot have any effect on the given seeds:
+// regions, or provider scoped keys, that match no seed and toleration keys that match no seed taint.
+// The tolerations are the region and provider scoped ones of the seed options.
+func ValidateTolerations(opts SeedOpts, seeds []gardener_types.Seed) (warnings []string) {
+	tolerations := opts.tolerations()
+	regions := map[string]struct{}{}
+	taintKeys := map[string]struct{}{}
+	for _, seed := range seeds {
+		provider, region := seed.Spec.Provider.Type, seed.Spec.Provider.Region
+		regions[region] = struct{}{}
+		regions[TolerationsKey(provider, region)] = struct{}{}
+		regions[TolerationsKey(provider, ProviderWideRegion)] = struct{}{}
+		for _, taint := range seed.Spec.Taints {
+			taintKeys[taint.Key] = struct{}{}
+		}
+	}
+
+	tolerationRegions := make([]string, 0, len(tolerations))
+	for region := range tolerations {
+		tolerationRegions = append(tolerationRegions, region)
+	}
+	slices.Sort(tolerationRegions)
+
+	for _, region := range tolerationRegions {
+		if _, found := regions[region]; !found {
+			warnings = append(warnings, fmt.Sprintf("tolerations region %q matches no seed region", region))
+		}
+
+		for _, toleration := range tolerations[region] {
+			if _, found := taintKeys[toleration.Key]; !found {
+				warnings = append(warnings, fmt.Sprintf("toleration key %q in region %q matches no seed taint", toleration.Key, region))
+			}
+		}
+	}
+
+	return warnings
+}
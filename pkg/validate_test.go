@@ -0,0 +1,76 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTolerations(t *testing.T) {
+	testCases := []struct {
+		name                string
+		seeds               []gardener_types.Seed
+		tolerations         config.TolerationsConfig
+		providerTolerations config.TolerationsConfig
+		expected            []string
+	}{
+		{
+			name: "no tolerations",
+			seeds: []gardener_types.Seed{
+				taintedSeed(testRegion1, gardener_types.SeedTaint{Key: testTaintKey1}),
+			},
+		},
+		{
+			name: "all tolerations match",
+			seeds: []gardener_types.Seed{
+				taintedSeed(testRegion1, gardener_types.SeedTaint{Key: testTaintKey1}),
+				taintedSeed(testRegion2, gardener_types.SeedTaint{Key: testTaintKey2}),
+			},
+			tolerations: config.TolerationsConfig{
+				testRegion1: {{Key: testTaintKey1}},
+				testRegion2: {{Key: testTaintKey1}, {Key: testTaintKey2}},
+			},
+		},
+		{
+			name: "provider scoped keys",
+			seeds: []gardener_types.Seed{
+				taintedSeed(testRegion1, gardener_types.SeedTaint{Key: testTaintKey1}),
+			},
+			providerTolerations: config.TolerationsConfig{
+				seeker.TolerationsKey(testProviderType1, testRegion1):               {{Key: testTaintKey1}},
+				seeker.TolerationsKey(testProviderType1, seeker.ProviderWideRegion): {{Key: testTaintKey1}},
+				seeker.TolerationsKey(testProviderType2, testRegion1):               {{Key: testTaintKey1}},
+			},
+			expected: []string{
+				`tolerations region "test-provider-type2/test-region1" matches no seed region`,
+			},
+		},
+		{
+			name: "unknown region and key",
+			seeds: []gardener_types.Seed{
+				taintedSeed(testRegion1, gardener_types.SeedTaint{Key: testTaintKey1}),
+			},
+			tolerations: config.TolerationsConfig{
+				testRegion1: {{Key: testTaintKey1}, {Key: testTaintKey2}},
+				testRegion3: {{Key: testTaintKey1}},
+			},
+			expected: []string{
+				`toleration key "test-other-key-taint" in region "test-region1" matches no seed taint`,
+				`tolerations region "test-region3" matches no seed region`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.ValidateTolerations(seeker.SeedOpts{Tolerations: testCase.tolerations, ProviderTolerations: testCase.providerTolerations}, testCase.seeds)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package seeker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/utils/ptr"
+)
+
+var ErrInvalidVersionConstraint = errors.New("invalid version constraint")
+
+// VersionOpts are semver constraints the versions of a seed have to satisfy, e.g. `>= 1.110`.
+// Empty constraints disable the check, seeds with an unknown version do not satisfy a constraint.
+type VersionOpts struct {
+	// Gardener constrains the gardenlet version, seed.Status.Gardener.Version.
+	Gardener string `json:"gardener,omitempty"`
+	// Kubernetes constrains the Kubernetes version of the seed cluster, seed.Status.KubernetesVersion.
+	Kubernetes string `json:"kubernetes,omitempty"`
+}
+
+func (opts VersionOpts) Validate() error {
+	constraints := []struct{ name, constraint string }{
+		{"gardener", opts.Gardener},
+		{"kubernetes", opts.Kubernetes},
+	}
+	for _, c := range constraints {
+		if c.constraint == "" {
+			continue
+		}
+		if _, err := semver.NewConstraint(c.constraint); err != nil {
+			return fmt.Errorf("%w: %s version %q: %w", ErrInvalidVersionConstraint, c.name, c.constraint, err)
+		}
+	}
+	return nil
+}
+
+func gardenerVersion(seed *gardener_types.Seed) string {
+	if seed.Status.Gardener == nil {
+		return ""
+	}
+	return seed.Status.Gardener.Version
+}
+
+func kubernetesVersion(seed *gardener_types.Seed) string {
+	return ptr.Deref(seed.Status.KubernetesVersion, "")
+}
+
+// unsatisfiedVersions returns the names of the versions of the seed not satisfying the constraints.
+func unsatisfiedVersions(seed *gardener_types.Seed, opts VersionOpts) (out []string) {
+	if !versionSatisfies(gardenerVersion(seed), opts.Gardener) {
+		out = append(out, "gardener")
+	}
+	if !versionSatisfies(kubernetesVersion(seed), opts.Kubernetes) {
+		out = append(out, "kubernetes")
+	}
+	return out
+}
+
+func versionSatisfies(version, constraint string) bool {
+	if constraint == "" {
+		return true
+	}
+
+	// the constraints are validated when loaded, an invalid one is treated as not satisfied
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false
+	}
+
+	parsed, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return constraints.Check(parsed)
+}
@@ -0,0 +1,66 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedCanBeUsedWithVersions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seed     gardener_types.Seed
+		versions seeker.VersionOpts
+		expected bool
+	}{
+		{
+			name:     "no constraints",
+			seed:     newSeed(),
+			expected: true,
+		},
+		{
+			name:     "versions satisfied",
+			seed:     newSeed(withVersions("v1.110.2", "1.31.4")),
+			versions: seeker.VersionOpts{Gardener: ">= 1.110", Kubernetes: ">= 1.30, < 1.34"},
+			expected: true,
+		},
+		{
+			name:     "gardenlet too old",
+			seed:     newSeed(withVersions("v1.109.0", "1.31.4")),
+			versions: seeker.VersionOpts{Gardener: ">= 1.110"},
+		},
+		{
+			name:     "kubernetes too old",
+			seed:     newSeed(withVersions("v1.110.2", "1.29.8")),
+			versions: seeker.VersionOpts{Kubernetes: ">= 1.30"},
+		},
+		{
+			name:     "unknown gardenlet version",
+			seed:     newSeed(withVersions("", "1.31.4")),
+			versions: seeker.VersionOpts{Gardener: ">= 1.110"},
+		},
+		{
+			name:     "unknown kubernetes version",
+			seed:     newSeed(withVersions("v1.110.2", "")),
+			versions: seeker.VersionOpts{Kubernetes: ">= 1.30"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.SeedCanBeUsed(&testCase.seed, seeker.SeedOpts{Versions: testCase.versions})
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestVersionOptsValidate(t *testing.T) {
+	require.NoError(t, seeker.VersionOpts{}.Validate())
+	require.NoError(t, seeker.VersionOpts{Gardener: "~1.110", Kubernetes: ">= 1.30"}.Validate())
+	require.ErrorIs(t, seeker.VersionOpts{Gardener: "latest"}.Validate(), seeker.ErrInvalidVersionConstraint)
+}